@@ -0,0 +1,80 @@
+// This file contains adapters for hash functions that need to see their
+// entire input at once, for example because the length is mixed into the
+// initial state.
+// Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version.
+
+package dgohash
+
+// buffered32 adapts a one-shot hash function to the hash.Hash32 interface by
+// keeping a copy of everything written until Sum32 is called.
+type buffered32 struct {
+	f         func(data []byte) uint32
+	blockSize int
+	buf       []byte
+}
+
+func (b *buffered32) Size() int      { return 4 }
+func (b *buffered32) BlockSize() int { return b.blockSize }
+func (b *buffered32) Reset()         { b.buf = b.buf[:0] }
+func (b *buffered32) Sum32() uint32  { return b.f(b.buf) }
+
+func (b *buffered32) Write(data []byte) (int, error) {
+	b.buf = append(b.buf, data...)
+	return len(data), nil
+}
+
+func (b *buffered32) Sum(in []byte) []byte {
+	v := b.Sum32()
+	return append(in, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// buffered64 is the hash.Hash64 version of buffered32
+type buffered64 struct {
+	f         func(data []byte) uint64
+	blockSize int
+	buf       []byte
+}
+
+func (b *buffered64) Size() int      { return 8 }
+func (b *buffered64) BlockSize() int { return b.blockSize }
+func (b *buffered64) Reset()         { b.buf = b.buf[:0] }
+func (b *buffered64) Sum64() uint64  { return b.f(b.buf) }
+
+func (b *buffered64) Write(data []byte) (int, error) {
+	b.buf = append(b.buf, data...)
+	return len(data), nil
+}
+
+func (b *buffered64) Sum(in []byte) []byte {
+	v := b.Sum64()
+	return append(in, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// buffered128 is the Hash128 version of buffered32
+type buffered128 struct {
+	f         func(data []byte) (uint64, uint64)
+	blockSize int
+	buf       []byte
+}
+
+func (b *buffered128) Size() int                { return 16 }
+func (b *buffered128) BlockSize() int           { return b.blockSize }
+func (b *buffered128) Reset()                   { b.buf = b.buf[:0] }
+func (b *buffered128) Sum128() (uint64, uint64) { return b.f(b.buf) }
+
+// Sum64 returns the first 64 bits of the 128-bit hash
+func (b *buffered128) Sum64() uint64 {
+	h1, _ := b.f(b.buf)
+	return h1
+}
+
+func (b *buffered128) Write(data []byte) (int, error) {
+	b.buf = append(b.buf, data...)
+	return len(data), nil
+}
+
+func (b *buffered128) Sum(in []byte) []byte {
+	h1, h2 := b.Sum128()
+	return appendSum128(in, h1, h2)
+}
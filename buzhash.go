@@ -0,0 +1,126 @@
+// This file is an implementation of Buzhash, the cyclic polynomial rolling hash, by Robert Uzgalis
+// The algorithm is described in Cohen, "Recursive Hashing Functions for n-Grams", ACM TOIS 15(3), 1997
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// The hash of c1..cn is rotl(T[c1], n-1) ^ rotl(T[c2], n-2) ^ ... ^ T[cn].
+// Write adds bytes to the end of the window without removing any; once the
+// first window bytes have been written, Roll moves the window along by one.
+// The byte table is filled from the seed with splitmix64.
+
+package dgohash
+
+import (
+	"hash"
+)
+
+// RollingHash32 is the common interface implemented by the 32-bit rolling hash functions.
+// Roll removes out from the start of the window and adds in to its end.
+type RollingHash32 interface {
+	hash.Hash32
+	Roll(out, in byte)
+}
+
+// RollingHash64 is the common interface implemented by the 64-bit rolling hash functions
+type RollingHash64 interface {
+	hash.Hash64
+	Roll(out, in byte)
+}
+
+type buzhash32 struct {
+	table  [256]uint32
+	window uint8  // the window size, mod 32
+	h      uint32 // our hash state
+}
+
+// NewBuzhash32 returns a new RollingHash32 object computing the 32-bit Buzhash of windows of the given size, with the byte table chosen by seed.
+// It panics if the window size isn't positive.
+func NewBuzhash32(window int, seed uint64) RollingHash32 {
+	if window < 1 {
+		panic("dgohash: Buzhash window size must be positive")
+	}
+	b := &buzhash32{window: uint8(window % 32)}
+	for i := range b.table {
+		b.table[i] = uint32(splitmix64(&seed))
+	}
+	return b
+}
+
+func (b *buzhash32) Size() int      { return 4 }
+func (b *buzhash32) BlockSize() int { return 1 }
+func (b *buzhash32) Reset()         { b.h = 0 }
+
+func (b *buzhash32) Write(data []byte) (int, error) {
+
+	h := b.h
+
+	for _, c := range data {
+		h = rotl32(h, 1) ^ b.table[c]
+	}
+
+	b.h = h
+
+	return len(data), nil
+}
+
+func (b *buzhash32) Roll(out, in byte) {
+	b.h = rotl32(b.h, 1) ^ rotl32(b.table[out], b.window) ^ b.table[in]
+}
+
+func (b *buzhash32) Sum32() uint32 {
+	return b.h
+}
+
+func (b *buzhash32) Sum(in []byte) []byte {
+	v := b.h
+	return append(in, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+type buzhash64 struct {
+	table  [256]uint64
+	window uint8  // the window size, mod 64
+	h      uint64 // our hash state
+}
+
+// NewBuzhash64 returns a new RollingHash64 object computing the 64-bit Buzhash of windows of the given size, with the byte table chosen by seed.
+// It panics if the window size isn't positive.
+func NewBuzhash64(window int, seed uint64) RollingHash64 {
+	if window < 1 {
+		panic("dgohash: Buzhash window size must be positive")
+	}
+	b := &buzhash64{window: uint8(window % 64)}
+	for i := range b.table {
+		b.table[i] = splitmix64(&seed)
+	}
+	return b
+}
+
+func (b *buzhash64) Size() int      { return 8 }
+func (b *buzhash64) BlockSize() int { return 1 }
+func (b *buzhash64) Reset()         { b.h = 0 }
+
+func (b *buzhash64) Write(data []byte) (int, error) {
+
+	h := b.h
+
+	for _, c := range data {
+		h = rotl64(h, 1) ^ b.table[c]
+	}
+
+	b.h = h
+
+	return len(data), nil
+}
+
+func (b *buzhash64) Roll(out, in byte) {
+	b.h = rotl64(b.h, 1) ^ rotl64(b.table[out], b.window) ^ b.table[in]
+}
+
+func (b *buzhash64) Sum64() uint64 {
+	return b.h
+}
+
+func (b *buzhash64) Sum(in []byte) []byte {
+	v := b.h
+	return append(in, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
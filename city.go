@@ -0,0 +1,455 @@
+// This file is an implementation of the CityHash family of hash functions (v1.1) by Geoff Pike and Jyrki Alakuijala
+// The code is translated from the MIT-licensed source code at https://github.com/google/cityhash
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// CityHash picks a different code path depending on the total length of the
+// input, so it can't be computed incrementally.  The hash.Hash versions
+// returned here buffer everything written until the sum is requested.
+
+package dgohash
+
+import (
+	"hash"
+)
+
+// Some primes between 2^63 and 2^64 for various uses.
+const (
+	cityK0 = uint64(0xc3a5c85c97cb3127)
+	cityK1 = uint64(0xb492b66fbe98f273)
+	cityK2 = uint64(0x9ae16a3b2f90404f)
+)
+
+// NewCityHash32 returns a new hash.Hash32 object computing CityHash32
+func NewCityHash32() hash.Hash32 {
+	return &buffered32{f: CityHash32, blockSize: 20}
+}
+
+// NewCityHash64 returns a new hash.Hash64 object computing CityHash64
+func NewCityHash64() hash.Hash64 {
+	return &buffered64{f: CityHash64, blockSize: 64}
+}
+
+// NewCityHash64Seed returns a new hash.Hash64 object computing CityHash64WithSeed
+func NewCityHash64Seed(seed uint64) hash.Hash64 {
+	return &buffered64{f: func(data []byte) uint64 { return CityHash64WithSeed(data, seed) }, blockSize: 64}
+}
+
+// NewCityHash64Seeds returns a new hash.Hash64 object computing CityHash64WithSeeds
+func NewCityHash64Seeds(seed0, seed1 uint64) hash.Hash64 {
+	return &buffered64{f: func(data []byte) uint64 { return CityHash64WithSeeds(data, seed0, seed1) }, blockSize: 64}
+}
+
+// NewCityHash128 returns a new Hash128 object computing CityHash128
+func NewCityHash128() Hash128 {
+	return &buffered128{f: CityHash128, blockSize: 128}
+}
+
+// NewCityHash128Seed returns a new Hash128 object computing CityHash128WithSeed
+func NewCityHash128Seed(seed0, seed1 uint64) Hash128 {
+	return &buffered128{f: func(data []byte) (uint64, uint64) { return CityHash128WithSeed(data, seed0, seed1) }, blockSize: 128}
+}
+
+func rotr32(x uint32, r uint8) uint32 {
+	return (x >> r) | (x << (32 - r))
+}
+
+func rotr64(x uint64, r uint8) uint64 {
+	return (x >> r) | (x << (64 - r))
+}
+
+func bswap32(x uint32) uint32 {
+	return x>>24 | (x>>8)&0xff00 | (x<<8)&0xff0000 | x<<24
+}
+
+func bswap64(x uint64) uint64 {
+	return uint64(bswap32(uint32(x)))<<32 | uint64(bswap32(uint32(x>>32)))
+}
+
+// Helper from Murmur3 for combining two 32-bit values.
+func cityMur(a, h uint32) uint32 {
+	a *= c1
+	a = rotr32(a, 17)
+	a *= c2
+	h ^= a
+	h = rotr32(h, 19)
+	return h*5 + 0xe6546b64
+}
+
+func cityHash32Len13to24(s []byte) uint32 {
+	length := len(s)
+	a := readLE32(s[(length>>1)-4:])
+	b := readLE32(s[4:])
+	c := readLE32(s[length-8:])
+	d := readLE32(s[length>>1:])
+	e := readLE32(s)
+	f := readLE32(s[length-4:])
+	h := uint32(length)
+
+	return fmix32(cityMur(f, cityMur(e, cityMur(d, cityMur(c, cityMur(b, cityMur(a, h)))))))
+}
+
+func cityHash32Len0to4(s []byte) uint32 {
+	b := uint32(0)
+	c := uint32(9)
+	for _, v := range s {
+		// the reference code reads the input as signed chars
+		b = b*c1 + uint32(int8(v))
+		c ^= b
+	}
+	return fmix32(cityMur(b, cityMur(uint32(len(s)), c)))
+}
+
+func cityHash32Len5to12(s []byte) uint32 {
+	length := len(s)
+	a := uint32(length)
+	b := uint32(length) * 5
+	c := uint32(9)
+	d := b
+	a += readLE32(s)
+	b += readLE32(s[length-4:])
+	c += readLE32(s[(length>>1)&4:])
+	return fmix32(cityMur(c, cityMur(b, cityMur(a, d))))
+}
+
+// CityHash32 returns the 32-bit CityHash of s
+func CityHash32(s []byte) uint32 {
+
+	length := len(s)
+
+	if length <= 24 {
+		if length <= 12 {
+			if length <= 4 {
+				return cityHash32Len0to4(s)
+			}
+			return cityHash32Len5to12(s)
+		}
+		return cityHash32Len13to24(s)
+	}
+
+	// length > 24
+	h := uint32(length)
+	g := c1 * uint32(length)
+	f := g
+	a0 := rotr32(readLE32(s[length-4:])*c1, 17) * c2
+	a1 := rotr32(readLE32(s[length-8:])*c1, 17) * c2
+	a2 := rotr32(readLE32(s[length-16:])*c1, 17) * c2
+	a3 := rotr32(readLE32(s[length-12:])*c1, 17) * c2
+	a4 := rotr32(readLE32(s[length-20:])*c1, 17) * c2
+	h ^= a0
+	h = rotr32(h, 19)
+	h = h*5 + 0xe6546b64
+	h ^= a2
+	h = rotr32(h, 19)
+	h = h*5 + 0xe6546b64
+	g ^= a1
+	g = rotr32(g, 19)
+	g = g*5 + 0xe6546b64
+	g ^= a3
+	g = rotr32(g, 19)
+	g = g*5 + 0xe6546b64
+	f += a4
+	f = rotr32(f, 19)
+	f = f*5 + 0xe6546b64
+
+	for iters := (length - 1) / 20; iters > 0; iters-- {
+		a0 := rotr32(readLE32(s)*c1, 17) * c2
+		a1 := readLE32(s[4:])
+		a2 := rotr32(readLE32(s[8:])*c1, 17) * c2
+		a3 := rotr32(readLE32(s[12:])*c1, 17) * c2
+		a4 := readLE32(s[16:])
+		h ^= a0
+		h = rotr32(h, 18)
+		h = h*5 + 0xe6546b64
+		f += a1
+		f = rotr32(f, 19)
+		f = f * c1
+		g += a2
+		g = rotr32(g, 18)
+		g = g*5 + 0xe6546b64
+		h ^= a3 + a1
+		h = rotr32(h, 19)
+		h = h*5 + 0xe6546b64
+		g ^= a4
+		g = bswap32(g) * 5
+		h += a4 * 5
+		h = bswap32(h)
+		f += a0
+		f, g, h = g, h, f
+		s = s[20:]
+	}
+
+	g = rotr32(g, 11) * c1
+	g = rotr32(g, 17) * c1
+	f = rotr32(f, 11) * c1
+	f = rotr32(f, 17) * c1
+	h = rotr32(h+g, 19)
+	h = h*5 + 0xe6546b64
+	h = rotr32(h, 17) * c1
+	h = rotr32(h+f, 19)
+	h = h*5 + 0xe6546b64
+	h = rotr32(h, 17) * c1
+	return h
+}
+
+func cityShiftMix(val uint64) uint64 {
+	return val ^ (val >> 47)
+}
+
+// the Hash128to64() function from the reference code
+func cityHashLen16(u, v uint64) uint64 {
+	return cityHashLen16Mul(u, v, 0x9ddfea08eb382d69)
+}
+
+// Murmur-inspired hashing.
+func cityHashLen16Mul(u, v, mul uint64) uint64 {
+	a := (u ^ v) * mul
+	a ^= a >> 47
+	b := (v ^ a) * mul
+	b ^= b >> 47
+	b *= mul
+	return b
+}
+
+func cityHashLen0to16(s []byte) uint64 {
+
+	length := len(s)
+
+	if length >= 8 {
+		mul := cityK2 + uint64(length)*2
+		a := readLE64(s) + cityK2
+		b := readLE64(s[length-8:])
+		c := rotr64(b, 37)*mul + a
+		d := (rotr64(a, 25) + b) * mul
+		return cityHashLen16Mul(c, d, mul)
+	}
+
+	if length >= 4 {
+		mul := cityK2 + uint64(length)*2
+		a := uint64(readLE32(s))
+		return cityHashLen16Mul(uint64(length)+(a<<3), uint64(readLE32(s[length-4:])), mul)
+	}
+
+	if length > 0 {
+		a := s[0]
+		b := s[length>>1]
+		c := s[length-1]
+		y := uint32(a) + uint32(b)<<8
+		z := uint32(length) + uint32(c)<<2
+		return cityShiftMix(uint64(y)*cityK2^uint64(z)*cityK0) * cityK2
+	}
+
+	return cityK2
+}
+
+// This probably works well for 16-byte strings as well, but it may be overkill
+// in that case.
+func cityHashLen17to32(s []byte) uint64 {
+	length := len(s)
+	mul := cityK2 + uint64(length)*2
+	a := readLE64(s) * cityK1
+	b := readLE64(s[8:])
+	c := readLE64(s[length-8:]) * mul
+	d := readLE64(s[length-16:]) * cityK2
+	return cityHashLen16Mul(rotr64(a+b, 43)+rotr64(c, 30)+d, a+rotr64(b+cityK2, 18)+c, mul)
+}
+
+// Return a 16-byte hash for 48 bytes.  Quick and dirty.
+// Callers do best to use "random-looking" values for a and b.
+func cityWeakHashLen32WithSeedsWords(w, x, y, z, a, b uint64) (uint64, uint64) {
+	a += w
+	b = rotr64(b+a+z, 21)
+	c := a
+	a += x
+	a += y
+	b += rotr64(a, 44)
+	return a + z, b + c
+}
+
+// Return a 16-byte hash for s[0] ... s[31], a, and b.  Quick and dirty.
+func cityWeakHashLen32WithSeeds(s []byte, a, b uint64) (uint64, uint64) {
+	return cityWeakHashLen32WithSeedsWords(readLE64(s), readLE64(s[8:]), readLE64(s[16:]), readLE64(s[24:]), a, b)
+}
+
+// Return an 8-byte hash for 33 to 64 bytes.
+func cityHashLen33to64(s []byte) uint64 {
+	length := len(s)
+	mul := cityK2 + uint64(length)*2
+	a := readLE64(s) * cityK2
+	b := readLE64(s[8:])
+	c := readLE64(s[length-24:])
+	d := readLE64(s[length-32:])
+	e := readLE64(s[16:]) * cityK2
+	f := readLE64(s[24:]) * 9
+	g := readLE64(s[length-8:])
+	h := readLE64(s[length-16:]) * mul
+	u := rotr64(a+g, 43) + (rotr64(b, 30)+c)*9
+	v := ((a + g) ^ d) + f + 1
+	w := bswap64((u+v)*mul) + h
+	x := rotr64(e+f, 42) + c
+	y := (bswap64((v+w)*mul) + g) * mul
+	z := e + f + c
+	a = bswap64((x+z)*mul+y) + b
+	b = cityShiftMix((z+a)*mul+d+h) * mul
+	return b + x
+}
+
+// CityHash64 returns the 64-bit CityHash of s
+func CityHash64(s []byte) uint64 {
+
+	length := len(s)
+
+	if length <= 32 {
+		if length <= 16 {
+			return cityHashLen0to16(s)
+		}
+		return cityHashLen17to32(s)
+	}
+
+	if length <= 64 {
+		return cityHashLen33to64(s)
+	}
+
+	// For strings over 64 bytes we hash the end first, and then as we
+	// loop we keep 56 bytes of state: v, w, x, y, and z.
+	x := readLE64(s[length-40:])
+	y := readLE64(s[length-16:]) + readLE64(s[length-56:])
+	z := cityHashLen16(readLE64(s[length-48:])+uint64(length), readLE64(s[length-24:]))
+	v1, v2 := cityWeakHashLen32WithSeeds(s[length-64:], uint64(length), z)
+	w1, w2 := cityWeakHashLen32WithSeeds(s[length-32:], y+cityK1, x)
+	x = x*cityK1 + readLE64(s)
+
+	// Decrease length to the nearest multiple of 64, and operate on 64-byte chunks.
+	for s = s[:(length-1)&^63]; len(s) > 0; s = s[64:] {
+		x = rotr64(x+y+v1+readLE64(s[8:]), 37) * cityK1
+		y = rotr64(y+v2+readLE64(s[48:]), 42) * cityK1
+		x ^= w2
+		y += v1 + readLE64(s[40:])
+		z = rotr64(z+w1, 33) * cityK1
+		v1, v2 = cityWeakHashLen32WithSeeds(s, v2*cityK1, x+w1)
+		w1, w2 = cityWeakHashLen32WithSeeds(s[32:], z+w2, y+readLE64(s[16:]))
+		z, x = x, z
+	}
+
+	return cityHashLen16(cityHashLen16(v1, w1)+cityShiftMix(y)*cityK1+z, cityHashLen16(v2, w2)+x)
+}
+
+// CityHash64WithSeed returns the 64-bit CityHash of s, with seed mixed in to the result
+func CityHash64WithSeed(s []byte, seed uint64) uint64 {
+	return CityHash64WithSeeds(s, cityK2, seed)
+}
+
+// CityHash64WithSeeds returns the 64-bit CityHash of s, with both seeds mixed in to the result
+func CityHash64WithSeeds(s []byte, seed0, seed1 uint64) uint64 {
+	return cityHashLen16(CityHash64(s)-seed0, seed1)
+}
+
+// A subroutine for CityHash128().  Returns a decent 128-bit hash for strings
+// of any length representable in signed long.  Based on City and Murmur.
+func cityMurmur(s []byte, seed0, seed1 uint64) (uint64, uint64) {
+
+	length := len(s)
+
+	a := seed0
+	b := seed1
+	var c, d uint64
+
+	if length <= 16 {
+		a = cityShiftMix(a*cityK1) * cityK1
+		c = b*cityK1 + cityHashLen0to16(s)
+		if length >= 8 {
+			d = cityShiftMix(a + readLE64(s))
+		} else {
+			d = cityShiftMix(a + c)
+		}
+	} else {
+		c = cityHashLen16(readLE64(s[length-8:])+cityK1, a)
+		d = cityHashLen16(b+uint64(length), c+readLE64(s[length-16:]))
+		a += d
+		for l := length - 16; l > 0; l -= 16 {
+			a ^= cityShiftMix(readLE64(s)*cityK1) * cityK1
+			a *= cityK1
+			b ^= a
+			c ^= cityShiftMix(readLE64(s[8:])*cityK1) * cityK1
+			c *= cityK1
+			d ^= c
+			s = s[16:]
+		}
+	}
+
+	a = cityHashLen16(a, c)
+	b = cityHashLen16(d, b)
+
+	return a ^ b, cityHashLen16(b, a)
+}
+
+// CityHash128WithSeed returns the 128-bit CityHash of s, using seed0 and seed1 as the low and high words of the 128-bit seed
+func CityHash128WithSeed(s []byte, seed0, seed1 uint64) (uint64, uint64) {
+
+	if len(s) < 128 {
+		return cityMurmur(s, seed0, seed1)
+	}
+
+	// saved for hashing the tail
+	t := s
+
+	// We expect len >= 128 to be the common case.  Keep 56 bytes of state:
+	// v, w, x, y, and z.
+	x := seed0
+	y := seed1
+	z := uint64(len(s)) * cityK1
+	v1 := rotr64(y^cityK1, 49)*cityK1 + readLE64(s)
+	v2 := rotr64(v1, 42)*cityK1 + readLE64(s[8:])
+	w1 := rotr64(y+z, 35)*cityK1 + x
+	w2 := rotr64(x+readLE64(s[88:]), 53) * cityK1
+
+	// This is the same inner loop as CityHash64(), manually unrolled.
+	for len(s) >= 128 {
+		for i := 0; i < 2; i++ {
+			x = rotr64(x+y+v1+readLE64(s[8:]), 37) * cityK1
+			y = rotr64(y+v2+readLE64(s[48:]), 42) * cityK1
+			x ^= w2
+			y += v1 + readLE64(s[40:])
+			z = rotr64(z+w1, 33) * cityK1
+			v1, v2 = cityWeakHashLen32WithSeeds(s, v2*cityK1, x+w1)
+			w1, w2 = cityWeakHashLen32WithSeeds(s[32:], z+w2, y+readLE64(s[16:]))
+			z, x = x, z
+			s = s[64:]
+		}
+	}
+
+	x += rotr64(v1+z, 49) * cityK0
+	y = y*cityK0 + rotr64(w2, 37)
+	z = z*cityK0 + rotr64(w1, 27)
+	w1 *= 9
+	v1 *= cityK0
+
+	// If 0 < len(s) < 128, hash up to 4 chunks of 32 bytes each from the end of the input.
+	for tailDone := 0; tailDone < len(s); {
+		tailDone += 32
+		p := t[len(t)-tailDone:]
+		y = rotr64(x+y, 42)*cityK0 + v2
+		w1 += readLE64(p[16:])
+		x = x*cityK0 + w1
+		z += w2 + readLE64(p)
+		w2 += v1
+		v1, v2 = cityWeakHashLen32WithSeeds(p, v1+z, v2)
+		v1 *= cityK0
+	}
+
+	// At this point our 56 bytes of state should contain more than
+	// enough information for a strong 128-bit hash.  We use two
+	// different 56-byte-to-8-byte hashes to get a 16-byte final result.
+	x = cityHashLen16(x, v1)
+	y = cityHashLen16(y+z, w1)
+
+	return cityHashLen16(x+v2, w2) + y, cityHashLen16(x+w2, y+v2)
+}
+
+// CityHash128 returns the 128-bit CityHash of s as its low and high 64-bit words
+func CityHash128(s []byte) (uint64, uint64) {
+	if len(s) >= 16 {
+		return CityHash128WithSeed(s[16:], readLE64(s), readLE64(s[8:])+cityK0)
+	}
+	return CityHash128WithSeed(s, cityK0, cityK1)
+}
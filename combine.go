@@ -0,0 +1,91 @@
+// This file contains functions to combine the Java, djb2 and SDBM hashes of two strings into the hash of their concatenation.
+// Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version.
+
+// Each of these hashes is h = B*h + c for a constant multiplier B, so
+// hashing b after a multiplies the state left by a by B^len(b) and then adds
+// the hash of b computed from a zero state.  djb2 starts from 5381 rather
+// than zero, which has to be taken out of the hash of b.  As with zlib's
+// crc32_combine(), the power is computed by repeated squaring, so the cost
+// is logarithmic in len2.  A negative len2 is rejected by returning all ones,
+// as CombineAdler32 does.
+
+package dgohash
+
+// pow32 returns b^n mod 2^32
+func pow32(b uint32, n int64) uint32 {
+	r := uint32(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			r *= b
+		}
+		b *= b
+	}
+	return r
+}
+
+// pow64 returns b^n mod 2^64
+func pow64(b uint64, n int64) uint64 {
+	r := uint64(1)
+	for ; n > 0; n >>= 1 {
+		if n&1 == 1 {
+			r *= b
+		}
+		b *= b
+	}
+	return r
+}
+
+// CombineJava32 returns the NewJava32 hash of the concatenation of two strings, given the hash h1 of the first and h2 of the second, which is len2 bytes long.
+// It returns 0xffffffff if len2 is negative.
+func CombineJava32(h1, h2 uint32, len2 int64) uint32 {
+	if len2 < 0 {
+		return 0xffffffff
+	}
+	return h1*pow32(31, len2) + h2
+}
+
+// CombineJava64 returns the NewJava64 hash of the concatenation of two strings, given the hash h1 of the first and h2 of the second, which is len2 bytes long.
+// It returns 0xffffffffffffffff if len2 is negative.
+func CombineJava64(h1, h2 uint64, len2 int64) uint64 {
+	if len2 < 0 {
+		return 0xffffffffffffffff
+	}
+	return h1*pow64(31, len2) + h2
+}
+
+// CombineDjb32 returns the NewDjb32 hash of the concatenation of two strings, given the hash h1 of the first and h2 of the second, which is len2 bytes long.
+// It returns 0xffffffff if len2 is negative.
+func CombineDjb32(h1, h2 uint32, len2 int64) uint32 {
+	if len2 < 0 {
+		return 0xffffffff
+	}
+	return (h1-5381)*pow32(33, len2) + h2
+}
+
+// CombineDjb64 returns the NewDjb64 hash of the concatenation of two strings, given the hash h1 of the first and h2 of the second, which is len2 bytes long.
+// It returns 0xffffffffffffffff if len2 is negative.
+func CombineDjb64(h1, h2 uint64, len2 int64) uint64 {
+	if len2 < 0 {
+		return 0xffffffffffffffff
+	}
+	return (h1-5381)*pow64(33, len2) + h2
+}
+
+// CombineSDBM32 returns the NewSDBM32 hash of the concatenation of two strings, given the hash h1 of the first and h2 of the second, which is len2 bytes long.
+// It returns 0xffffffff if len2 is negative.
+func CombineSDBM32(h1, h2 uint32, len2 int64) uint32 {
+	if len2 < 0 {
+		return 0xffffffff
+	}
+	return h1*pow32(65599, len2) + h2
+}
+
+// CombineSDBM64 returns the NewSDBM64 hash of the concatenation of two strings, given the hash h1 of the first and h2 of the second, which is len2 bytes long.
+// It returns 0xffffffffffffffff if len2 is negative.
+func CombineSDBM64(h1, h2 uint64, len2 int64) uint64 {
+	if len2 < 0 {
+		return 0xffffffffffffffff
+	}
+	return h1*pow64(65599, len2) + h2
+}
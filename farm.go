@@ -0,0 +1,221 @@
+// This file is an implementation of the FarmHash fingerprint functions by Geoff Pike
+// The code is translated from the MIT-licensed source code at https://github.com/google/farmhash
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// Unlike the rest of FarmHash, the fingerprints are guaranteed never to
+// change between releases or platforms.  Fingerprint64 is the function behind
+// BigQuery's FARM_FINGERPRINT(), which returns the result as a signed int64.
+// Fingerprint128 is defined to be CityHash128.
+//
+// As with CityHash, the hash.Hash versions have to buffer all their input.
+
+package dgohash
+
+import (
+	"hash"
+)
+
+// NewFarmFingerprint32 returns a new hash.Hash32 object computing FarmHash Fingerprint32
+func NewFarmFingerprint32() hash.Hash32 {
+	return &buffered32{f: FarmFingerprint32, blockSize: 20}
+}
+
+// NewFarmFingerprint64 returns a new hash.Hash64 object computing FarmHash Fingerprint64
+func NewFarmFingerprint64() hash.Hash64 {
+	return &buffered64{f: FarmFingerprint64, blockSize: 64}
+}
+
+// NewFarmFingerprint128 returns a new Hash128 object computing FarmHash Fingerprint128
+func NewFarmFingerprint128() Hash128 {
+	return &buffered128{f: FarmFingerprint128, blockSize: 128}
+}
+
+func farmHash32Len13to24(s []byte, seed uint32) uint32 {
+	length := len(s)
+	a := readLE32(s[(length>>1)-4:])
+	b := readLE32(s[4:])
+	c := readLE32(s[length-8:])
+	d := readLE32(s[length>>1:])
+	e := readLE32(s)
+	f := readLE32(s[length-4:])
+	h := d*c1 + uint32(length) + seed
+	a = rotr32(a, 12) + f
+	h = cityMur(c, h) + a
+	a = rotr32(a, 3) + c
+	h = cityMur(e, h) + a
+	a = rotr32(a+f, 12) + d
+	h = cityMur(b^seed, h) + a
+	return fmix32(h)
+}
+
+func farmHash32Len0to4(s []byte, seed uint32) uint32 {
+	b := seed
+	c := uint32(9)
+	for _, v := range s {
+		// the reference code reads the input as signed chars
+		b = b*c1 + uint32(int8(v))
+		c ^= b
+	}
+	return fmix32(cityMur(b, cityMur(uint32(len(s)), c)))
+}
+
+func farmHash32Len5to12(s []byte, seed uint32) uint32 {
+	length := len(s)
+	a := uint32(length)
+	b := uint32(length) * 5
+	c := uint32(9)
+	d := b + seed
+	a += readLE32(s)
+	b += readLE32(s[length-4:])
+	c += readLE32(s[(length>>1)&4:])
+	return fmix32(seed ^ cityMur(c, cityMur(b, cityMur(a, d))))
+}
+
+// FarmFingerprint32 returns the 32-bit FarmHash fingerprint of s
+func FarmFingerprint32(s []byte) uint32 {
+
+	length := len(s)
+
+	if length <= 24 {
+		if length <= 12 {
+			if length <= 4 {
+				return farmHash32Len0to4(s, 0)
+			}
+			return farmHash32Len5to12(s, 0)
+		}
+		return farmHash32Len13to24(s, 0)
+	}
+
+	// length > 24
+	h := uint32(length)
+	g := c1 * uint32(length)
+	f := g
+	a0 := rotr32(readLE32(s[length-4:])*c1, 17) * c2
+	a1 := rotr32(readLE32(s[length-8:])*c1, 17) * c2
+	a2 := rotr32(readLE32(s[length-16:])*c1, 17) * c2
+	a3 := rotr32(readLE32(s[length-12:])*c1, 17) * c2
+	a4 := rotr32(readLE32(s[length-20:])*c1, 17) * c2
+	h ^= a0
+	h = rotr32(h, 19)
+	h = h*5 + 0xe6546b64
+	h ^= a2
+	h = rotr32(h, 19)
+	h = h*5 + 0xe6546b64
+	g ^= a1
+	g = rotr32(g, 19)
+	g = g*5 + 0xe6546b64
+	g ^= a3
+	g = rotr32(g, 19)
+	g = g*5 + 0xe6546b64
+	f += a4
+	f = rotr32(f, 19) + 113
+
+	for iters := (length - 1) / 20; iters > 0; iters-- {
+		a := readLE32(s)
+		b := readLE32(s[4:])
+		c := readLE32(s[8:])
+		d := readLE32(s[12:])
+		e := readLE32(s[16:])
+		h += a
+		g += b
+		f += c
+		h = cityMur(d, h) + e
+		g = cityMur(c, g) + a
+		f = cityMur(b+e*c1, f) + d
+		f += g
+		g += f
+		s = s[20:]
+	}
+
+	g = rotr32(g, 11) * c1
+	g = rotr32(g, 17) * c1
+	f = rotr32(f, 11) * c1
+	f = rotr32(f, 17) * c1
+	h = rotr32(h+g, 19)
+	h = h*5 + 0xe6546b64
+	h = rotr32(h, 17) * c1
+	h = rotr32(h+f, 19)
+	h = h*5 + 0xe6546b64
+	h = rotr32(h, 17) * c1
+	return h
+}
+
+// Return an 8-byte hash for 33 to 64 bytes.
+func farmHashLen33to64(s []byte) uint64 {
+	length := len(s)
+	mul := cityK2 + uint64(length)*2
+	a := readLE64(s) * cityK2
+	b := readLE64(s[8:])
+	c := readLE64(s[length-8:]) * mul
+	d := readLE64(s[length-16:]) * cityK2
+	y := rotr64(a+b, 43) + rotr64(c, 30) + d
+	z := cityHashLen16Mul(y, a+rotr64(b+cityK2, 18)+c, mul)
+	e := readLE64(s[16:]) * mul
+	f := readLE64(s[24:])
+	g := (y + readLE64(s[length-32:])) * mul
+	h := (z + readLE64(s[length-24:])) * mul
+	return cityHashLen16Mul(rotr64(e+f, 43)+rotr64(g, 30)+h, e+rotr64(f+a, 18)+g, mul)
+}
+
+// FarmFingerprint64 returns the 64-bit FarmHash fingerprint of s
+func FarmFingerprint64(s []byte) uint64 {
+
+	seed := uint64(81)
+
+	length := len(s)
+
+	if length <= 32 {
+		if length <= 16 {
+			return cityHashLen0to16(s)
+		}
+		return cityHashLen17to32(s)
+	}
+
+	if length <= 64 {
+		return farmHashLen33to64(s)
+	}
+
+	// For strings over 64 bytes we loop.  Internal state consists of
+	// 56 bytes: v, w, x, y, and z.
+	var v1, v2, w1, w2 uint64
+	x := seed*cityK2 + readLE64(s)
+	y := seed*cityK1 + 113
+	z := cityShiftMix(y*cityK2+113) * cityK2
+
+	// the loop leaves 1 to 64 bytes to process, which are hashed as the last 64 bytes of the input
+	last64 := s[length-64:]
+
+	for s = s[:(length-1)&^63]; len(s) > 0; s = s[64:] {
+		x = rotr64(x+y+v1+readLE64(s[8:]), 37) * cityK1
+		y = rotr64(y+v2+readLE64(s[48:]), 42) * cityK1
+		x ^= w2
+		y += v1 + readLE64(s[40:])
+		z = rotr64(z+w1, 33) * cityK1
+		v1, v2 = cityWeakHashLen32WithSeeds(s, v2*cityK1, x+w1)
+		w1, w2 = cityWeakHashLen32WithSeeds(s[32:], z+w2, y+readLE64(s[16:]))
+		z, x = x, z
+	}
+
+	mul := cityK1 + ((z & 0xff) << 1)
+
+	s = last64
+	w1 += uint64(length-1) & 63
+	v1 += w1
+	w1 += v1
+	x = rotr64(x+y+v1+readLE64(s[8:]), 37) * mul
+	y = rotr64(y+v2+readLE64(s[48:]), 42) * mul
+	x ^= w2 * 9
+	y += v1*9 + readLE64(s[40:])
+	z = rotr64(z+w1, 33) * mul
+	v1, v2 = cityWeakHashLen32WithSeeds(s, v2*mul, x+w1)
+	w1, w2 = cityWeakHashLen32WithSeeds(s[32:], z+w2, y+readLE64(s[16:]))
+	z, x = x, z
+
+	return cityHashLen16Mul(cityHashLen16Mul(v1, w1, mul)+cityShiftMix(y)*cityK0+z, cityHashLen16Mul(v2, w2, mul)+x, mul)
+}
+
+// FarmFingerprint128 returns the 128-bit FarmHash fingerprint of s as its low and high 64-bit words
+func FarmFingerprint128(s []byte) (uint64, uint64) {
+	return CityHash128(s)
+}
@@ -0,0 +1,225 @@
+// This file is an implementation of Fletcher's checksum by John G. Fletcher, and the Adler-32 combine function by Mark Adler
+// The algorithm is described in "An Arithmetic Checksum for Serial Transmissions", IEEE Trans. Comm. 30(1), 1982; adler32_combine() is translated from zlib
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// Fletcher-16 sums bytes modulo 255, Fletcher-32 sums 16-bit words modulo
+// 65535 and Fletcher-64 sums 32-bit words modulo 2^32-1.  The words are read
+// little-endian, and a partial word at the end of the input is padded with
+// zeros.  The result is the second sum followed by the first.
+//
+// Appending data to a checksummed block adds a multiple of the block's first
+// sum to the second, so the checksum of a concatenation can be computed from
+// the checksums of its parts.  Because of the padding this only works when
+// the first part is a whole number of words.
+
+package dgohash
+
+import (
+	"hash"
+	"hash/adler32"
+)
+
+type fletcher struct {
+	size   int    // the word size in bytes: 1, 2 or 4
+	mod    uint64 // 2^(8*size)-1
+	s1, s2 uint64 // our checksum state
+	t      [4]byte
+	rem    int // how many bytes in t[] are valid
+}
+
+// the number of words that can be summed before s2 might overflow
+const fletcherMaxWords = 1 << 16
+
+// NewFletcher16 returns a new hash.Hash32 object computing the Fletcher-16 checksum
+func NewFletcher16() hash.Hash32 {
+	return newFletcher(1)
+}
+
+// NewFletcher32 returns a new hash.Hash32 object computing the Fletcher-32 checksum
+func NewFletcher32() hash.Hash32 {
+	return newFletcher(2)
+}
+
+// NewFletcher64 returns a new hash.Hash64 object computing the Fletcher-64 checksum
+func NewFletcher64() hash.Hash64 {
+	return newFletcher(4)
+}
+
+func newFletcher(size int) *fletcher {
+	return &fletcher{size: size, mod: 1<<(8*uint(size)) - 1}
+}
+
+func (f *fletcher) Size() int      { return 2 * f.size }
+func (f *fletcher) BlockSize() int { return 1 }
+func (f *fletcher) Reset()         { f.s1, f.s2, f.rem = 0, 0, 0 }
+
+func (f *fletcher) word(p []byte) uint64 {
+	switch f.size {
+	case 1:
+		return uint64(p[0])
+	case 2:
+		return uint64(p[0]) | uint64(p[1])<<8
+	}
+	return uint64(readLE32(p))
+}
+
+// sum the whole words in p into the state
+func (f *fletcher) update(p []byte) {
+
+	s1, s2 := f.s1, f.s2
+
+	for len(p) > 0 {
+		n := len(p) / f.size
+		if n > fletcherMaxWords {
+			n = fletcherMaxWords
+		}
+		for i := 0; i < n; i++ {
+			s1 += f.word(p)
+			s2 += s1
+			p = p[f.size:]
+		}
+		s1 %= f.mod
+		s2 %= f.mod
+	}
+
+	f.s1, f.s2 = s1, s2
+}
+
+func (f *fletcher) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+
+	if f.rem != 0 {
+
+		n := copy(f.t[f.rem:f.size], data)
+		f.rem += n
+
+		if f.rem < f.size {
+			return datalen, nil
+		}
+
+		f.update(f.t[:f.size])
+
+		// nothing is left in the tail
+		f.rem = 0
+		data = data[n:]
+	}
+
+	length := len(data)
+
+	// figure out the length of the tail, and round down b
+	rem := length % f.size
+	b := length - rem
+
+	f.update(data[:b])
+
+	// copy the tail for later
+	copy(f.t[:rem], data[b:])
+
+	f.rem = rem
+
+	return datalen, nil
+}
+
+func (f *fletcher) Sum64() uint64 {
+
+	s1, s2 := f.s1, f.s2
+
+	if f.rem != 0 {
+		var tail [4]byte
+		copy(tail[:], f.t[:f.rem])
+		s1 = (s1 + f.word(tail[:])) % f.mod
+		s2 = (s2 + s1) % f.mod
+	}
+
+	return s2<<(8*uint(f.size)) | s1
+}
+
+func (f *fletcher) Sum32() uint32 {
+	return uint32(f.Sum64())
+}
+
+func (f *fletcher) Sum(b []byte) []byte {
+	v := f.Sum64()
+	for i := f.Size() - 1; i >= 0; i-- {
+		b = append(b, byte(v>>(8*uint(i))))
+	}
+	return b
+}
+
+// combine the two halves of Fletcher checksums h1 and h2, where the second covers n words
+func fletcherCombine(h1, h2 uint64, n int64, shift uint) uint64 {
+	mod := uint64(1)<<shift - 1
+	a1, b1 := h1&mod, h1>>shift
+	a2, b2 := h2&mod, h2>>shift
+	s1 := (a1 + a2) % mod
+	s2 := (b1 + b2 + (uint64(n)%mod)*a1%mod) % mod
+	return s2<<shift | s1
+}
+
+// CombineFletcher16 returns the Fletcher-16 checksum of the concatenation of two blocks, given the checksum h1 of the first and h2 of the second, which is len2 bytes long.
+// As with CombineAdler32, it returns 0xffffffff if len2 is negative.
+func CombineFletcher16(h1, h2 uint32, len2 int64) uint32 {
+	if len2 < 0 {
+		return 0xffffffff
+	}
+	return uint32(fletcherCombine(uint64(h1), uint64(h2), len2, 8))
+}
+
+// CombineFletcher32 returns the Fletcher-32 checksum of the concatenation of two blocks, given the checksum h1 of the first and h2 of the second, which is len2 bytes long.
+// The first block must be an even number of bytes long.  As with CombineAdler32, it returns 0xffffffff if len2 is negative.
+func CombineFletcher32(h1, h2 uint32, len2 int64) uint32 {
+	if len2 < 0 {
+		return 0xffffffff
+	}
+	return uint32(fletcherCombine(uint64(h1), uint64(h2), (len2+1)/2, 16))
+}
+
+// CombineFletcher64 returns the Fletcher-64 checksum of the concatenation of two blocks, given the checksum h1 of the first and h2 of the second, which is len2 bytes long.
+// The first block must be a multiple of 4 bytes long.  It returns 0xffffffffffffffff if len2 is negative.
+func CombineFletcher64(h1, h2 uint64, len2 int64) uint64 {
+	if len2 < 0 {
+		return 0xffffffffffffffff
+	}
+	return fletcherCombine(h1, h2, (len2+3)/4, 32)
+}
+
+// NewAdler32 returns a new hash.Hash32 object computing the Adler-32 checksum.  This is the implementation in hash/adler32.
+func NewAdler32() hash.Hash32 {
+	return adler32.New()
+}
+
+// CombineAdler32 returns the Adler-32 checksum of the concatenation of two blocks, given the checksum h1 of the first and h2 of the second, which is len2 bytes long.
+// As in zlib, it returns 0xffffffff if len2 is negative.
+func CombineAdler32(h1, h2 uint32, len2 int64) uint32 {
+
+	// the largest prime smaller than 65536
+	const base = 65521
+
+	if len2 < 0 {
+		return 0xffffffff
+	}
+
+	rem := uint32(len2 % base)
+
+	sum1 := h1 & 0xffff
+	sum2 := rem * sum1 % base
+	sum1 += (h2 & 0xffff) + base - 1
+	sum2 += (h1 >> 16) + (h2 >> 16) + base - rem
+
+	if sum1 >= base {
+		sum1 -= base
+	}
+	if sum1 >= base {
+		sum1 -= base
+	}
+	if sum2 >= base<<1 {
+		sum2 -= base << 1
+	}
+	if sum2 >= base {
+		sum2 -= base
+	}
+
+	return sum1 | sum2<<16
+}
@@ -0,0 +1,110 @@
+// This file is an implementation of the Gear rolling hash by Wen Xia et al
+// The algorithm is described in "Ddelta: A Deduplication-Inspired Fast Delta Compression Approach", Performance Evaluation 79, 2014, and is the hash used by FastCDC
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// The hash is updated with h = h<<1 + G[c], so the hash of c1..cn is
+// G[c1]<<(n-1) + G[c2]<<(n-2) + ... + G[cn].  Bytes fall off the top of the
+// word on their own after 32 or 64 steps; for shorter windows Roll
+// subtracts the outgoing byte.  As with Buzhash, Write adds bytes without
+// removing any, and the gear table is filled from the seed with splitmix64.
+
+package dgohash
+
+type gear32 struct {
+	table  [256]uint32
+	window uint   // the window size
+	h      uint32 // our hash state
+}
+
+// NewGear32 returns a new RollingHash32 object computing the 32-bit Gear hash of windows of the given size, with the gear table chosen by seed.
+// It panics unless 1 <= window <= 32.
+func NewGear32(window int, seed uint64) RollingHash32 {
+	if window < 1 || window > 32 {
+		panic("dgohash: Gear window size out of range")
+	}
+	g := &gear32{window: uint(window)}
+	for i := range g.table {
+		g.table[i] = uint32(splitmix64(&seed))
+	}
+	return g
+}
+
+func (g *gear32) Size() int      { return 4 }
+func (g *gear32) BlockSize() int { return 1 }
+func (g *gear32) Reset()         { g.h = 0 }
+
+func (g *gear32) Write(data []byte) (int, error) {
+
+	h := g.h
+
+	for _, c := range data {
+		h = h<<1 + g.table[c]
+	}
+
+	g.h = h
+
+	return len(data), nil
+}
+
+func (g *gear32) Roll(out, in byte) {
+	g.h = g.h<<1 + g.table[in] - g.table[out]<<g.window
+}
+
+func (g *gear32) Sum32() uint32 {
+	return g.h
+}
+
+func (g *gear32) Sum(in []byte) []byte {
+	v := g.h
+	return append(in, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+type gear64 struct {
+	table  [256]uint64
+	window uint   // the window size
+	h      uint64 // our hash state
+}
+
+// NewGear64 returns a new RollingHash64 object computing the 64-bit Gear hash of windows of the given size, with the gear table chosen by seed.
+// It panics unless 1 <= window <= 64.
+func NewGear64(window int, seed uint64) RollingHash64 {
+	if window < 1 || window > 64 {
+		panic("dgohash: Gear window size out of range")
+	}
+	g := &gear64{window: uint(window)}
+	for i := range g.table {
+		g.table[i] = splitmix64(&seed)
+	}
+	return g
+}
+
+func (g *gear64) Size() int      { return 8 }
+func (g *gear64) BlockSize() int { return 1 }
+func (g *gear64) Reset()         { g.h = 0 }
+
+func (g *gear64) Write(data []byte) (int, error) {
+
+	h := g.h
+
+	for _, c := range data {
+		h = h<<1 + g.table[c]
+	}
+
+	g.h = h
+
+	return len(data), nil
+}
+
+func (g *gear64) Roll(out, in byte) {
+	g.h = g.h<<1 + g.table[in] - g.table[out]<<g.window
+}
+
+func (g *gear64) Sum64() uint64 {
+	return g.h
+}
+
+func (g *gear64) Sum(in []byte) []byte {
+	v := g.h
+	return append(in, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
@@ -6,7 +6,10 @@ package dgohash
 import (
 	"encoding/binary"
 	"hash"
+	"math/big"
+	"strings"
 	"testing"
+	"unicode/utf16"
 )
 
 type _Golden struct {
@@ -14,6 +17,21 @@ type _Golden struct {
 	in  string
 }
 
+type _Golden64 struct {
+	out uint64
+	in  string
+}
+
+type _Golden128 struct {
+	h1, h2 uint64
+	in     string
+}
+
+type _Golden256 struct {
+	h1, h2, h3, h4 uint64
+	in             string
+}
+
 // These tables were all generated from reference C implementations of the associated hashes.
 
 var goldenJava = []_Golden{
@@ -50,6 +68,40 @@ var goldenJava = []_Golden{
 	{0xda3df8dd, "How can you write a big system without C++?  -Paul Glick"},
 }
 
+var goldenJava64 = []_Golden64{
+	{0x0000000000000000, ""},
+	{0x0000000000000061, "a"},
+	{0x0000000000000c21, "ab"},
+	{0x0000000000017862, "abc"},
+	{0x00000000002d9442, "abcd"},
+	{0x000000000584f463, "abcde"},
+	{0x00000000ab199863, "abcdef"},
+	{0x00000014b8197464, "abcdefg"},
+	{0x000002824b151884, "abcdefgh"},
+	{0x00004dc7178df865, "abcdefghi"},
+	{0x00096b1bda3114a5, "abcdefghij"},
+	{0x23cae722507cbe5d, "Discard medicine more than two years old."},
+	{0x74c0c35bcf8332bc, "He who has a shady past knows that nice guys finish last."},
+	{0x5fe159b494ddaa0e, "I wouldn't marry him with a ten foot pole."},
+	{0x8eedb2ded1a67f32, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xf039fa7229e1993d, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x3ca5eeb246b8e871, "Nepal premier won't resign."},
+	{0x36df2a4480a347dc, "For every action there is an equal and opposite government program."},
+	{0x53d01415b560b45d, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x85692071123c79c6, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x3927b9c53f1ff283, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x8667a31fbf045f20, "size:  a.out:  bad magic"},
+	{0xa437b19530642382, "The major problem is with sendmail.  -Mark Horton"},
+	{0x7c6f14b3f11f3607, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xde39286ab68626c4, "If the enemy is within range, then so are you."},
+	{0x3569a4cc872d8aba, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x2292fcabd68213e8, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xbcee1786d55e6f3e, "C is as portable as Stonehedge!!"},
+	{0x675f0358b34d3565, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x83381fbf1f5a0d48, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xc602164fda3df8dd, "How can you write a big system without C++?  -Paul Glick"},
+}
+
 var goldenDjb32 = []_Golden{
 	{0x00001505, ""},
 	{0x0002b606, "a"},
@@ -84,6 +136,40 @@ var goldenDjb32 = []_Golden{
 	{0x9f8d455a, "How can you write a big system without C++?  -Paul Glick"},
 }
 
+var goldenDjb64 = []_Golden64{
+	{0x0000000000001505, ""},
+	{0x000000000002b606, "a"},
+	{0x0000000000597728, "ab"},
+	{0x000000000b885c8b, "abc"},
+	{0x000000017c93ee4f, "abcd"},
+	{0x000000310f11b894, "abcde"},
+	{0x00000652f148cb7a, "abcdef"},
+	{0x0000d0b11a623b21, "abcdefg"},
+	{0x001ae6d466a99fa9, "abcdefgh"},
+	{0x0377c1613bdd9532, "abcdefghi"},
+	{0x726fed88b7903bdc, "abcdefghij"},
+	{0xe299ae87a61e3ba6, "Discard medicine more than two years old."},
+	{0xd942c6e3f9827a7b, "He who has a shady past knows that nice guys finish last."},
+	{0xc7039f60a68ea4c5, "I wouldn't marry him with a ten foot pole."},
+	{0xc04f5585e31c5f19, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xd896c0fcbaef90a4, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x46d4b0dea2e15ace, "Nepal premier won't resign."},
+	{0x7fdd69473dd4f3e1, "For every action there is an equal and opposite government program."},
+	{0xe4ca3179effef6c6, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x634b5c2cbfd5d7e7, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x64a4e43f14a6762e, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x66fbf9f79dc2ebc3, "size:  a.out:  bad magic"},
+	{0x6d9b5c3c2fc35375, "The major problem is with sendmail.  -Mark Horton"},
+	{0xb18efa79bd0267c8, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xba8e36aa682419cf, "If the enemy is within range, then so are you."},
+	{0x066da26882f44aeb, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x9d63597b41db5feb, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xf9038372a3b3be6d, "C is as portable as Stonehedge!!"},
+	{0xf90920a442b489b4, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xd70e033b57e38ab3, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x8a25b7329f8d455a, "How can you write a big system without C++?  -Paul Glick"},
+}
+
 var goldenDjb32a = []_Golden{
 	{0x00001505, ""},
 	{0x0002b5c4, "a"},
@@ -118,6 +204,40 @@ var goldenDjb32a = []_Golden{
 	{0x7c8e18d0, "How can you write a big system without C++?  -Paul Glick"},
 }
 
+var goldenDjb64a = []_Golden64{
+	{0x0000000000001505, ""},
+	{0x000000000002b5c4, "a"},
+	{0x0000000000596e26, "ab"},
+	{0x000000000b873285, "abc"},
+	{0x000000017c6d8341, "abcd"},
+	{0x000000310a1deb04, "abcde"},
+	{0x000006524ddb4be2, "abcdef"},
+	{0x0000d09c0944c845, "abcdefg"},
+	{0x001ae41d31ddd08d, "abcdefgh"},
+	{0x037767c36d97e244, "abcdefghi"},
+	{0x7264603120942aae, "abcdefghij"},
+	{0x46624e2f61cc0ef4, "Discard medicine more than two years old."},
+	{0xf7d54fd878d05b1b, "He who has a shady past knows that nice guys finish last."},
+	{0x03e23cb15784addb, "I wouldn't marry him with a ten foot pole."},
+	{0x4fccb852cdaf4d3f, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x15889022ff825f4e, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x94f597cb2dca1828, "Nepal premier won't resign."},
+	{0x2cccc579a868a825, "For every action there is an equal and opposite government program."},
+	{0x61cdc11d8d5626a4, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x82cf96f3759731a1, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x7fc1ba3c2d10a0cc, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xf5494fc1217bca27, "size:  a.out:  bad magic"},
+	{0xf5bf4892ab9f58d7, "The major problem is with sendmail.  -Mark Horton"},
+	{0x72cfe9372c8605b0, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x00d92ee9e15bd3d5, "If the enemy is within range, then so are you."},
+	{0x6510792bce4c97cf, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x0eaa42efceb1e23d, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xb6ffae38006fd4a7, "C is as portable as Stonehedge!!"},
+	{0xf6e4a11bd6911f62, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x633911393290cdb7, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x1a8437807c8e18d0, "How can you write a big system without C++?  -Paul Glick"},
+}
+
 var goldenElf32 = []_Golden{
 	{0x00000000, ""},
 	{0x00000061, "a"},
@@ -186,6 +306,40 @@ var goldenSdbm = []_Golden{
 	{0x2129ea9d, "How can you write a big system without C++?  -Paul Glick"},
 }
 
+var goldenSdbm64 = []_Golden64{
+	{0x0000000000000000, ""},
+	{0x0000000000000061, "a"},
+	{0x0000000000611841, "ab"},
+	{0x000000613025f862, "abc"},
+	{0x00614810d1ba2082, "abcd"},
+	{0x60018dddbd500063, "abcde"},
+	{0x2e3fa6e1971318c3, "abcdef"},
+	{0x088ba89746761864, "abcdefg"},
+	{0xc2f5c3b06f740104, "abcdefgh"},
+	{0xbe2b97df6e904065, "abcdefghi"},
+	{0x6499ce8c75e4d945, "abcdefghij"},
+	{0xf09b4e18046d355d, "Discard medicine more than two years old."},
+	{0x99dd0809718c9e9c, "He who has a shady past knows that nice guys finish last."},
+	{0xed1d7fad14c663ae, "I wouldn't marry him with a ten foot pole."},
+	{0x215d7028f21ea712, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x305c1c372ab38c1d, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x4b4ba635354c9f71, "Nepal premier won't resign."},
+	{0x43feb7288b82905c, "For every action there is an equal and opposite government program."},
+	{0xd5cd5c712157591d, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x2794e071dda5cb46, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xefc2b19c87619563, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xe218cbd82dfefd80, "size:  a.out:  bad magic"},
+	{0x3118e0d4541955e2, "The major problem is with sendmail.  -Mark Horton"},
+	{0xa221dc82e8d7cbc7, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x450a3637434fdd24, "If the enemy is within range, then so are you."},
+	{0xf077ad5e3bd7247a, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x79c46803777bd008, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xd4d7126f60ac769e, "C is as portable as Stonehedge!!"},
+	{0xd44f51cb65db3345, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x856109a83a182aa8, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xee0743672129ea9d, "How can you write a big system without C++?  -Paul Glick"},
+}
+
 var goldenSqlite = []_Golden{
 	{0x00000000, ""},
 	{0x00000061, "a"},
@@ -220,6 +374,312 @@ var goldenSqlite = []_Golden{
 	{0xb1d7f9e5, "How can you write a big system without C++?  -Paul Glick"},
 }
 
+var goldenRS = []_Golden{
+	{0x00000000, ""},
+	{0x00000061, "a"},
+	{0x80e76fb1, "ab"},
+	{0xb1012aac, "abc"},
+	{0x987ac838, "abcd"},
+	{0xa4a13f5d, "abcde"},
+	{0xd10e67d9, "abcdef"},
+	{0xea304b38, "abcdefg"},
+	{0x5bebeb30, "abcdefgh"},
+	{0x78200519, "abcdefghi"},
+	{0x13b9ce41, "abcdefghij"},
+	{0xa3c2339f, "Discard medicine more than two years old."},
+	{0x3ddb1670, "He who has a shady past knows that nice guys finish last."},
+	{0x3f4578be, "I wouldn't marry him with a ten foot pole."},
+	{0x7dda685a, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x6528381f, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x6fb501e9, "Nepal premier won't resign."},
+	{0xff643b58, "For every action there is an equal and opposite government program."},
+	{0x483544cd, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x7a35275c, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x39fa8f0d, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x98226308, "size:  a.out:  bad magic"},
+	{0x253a2688, "The major problem is with sendmail.  -Mark Horton"},
+	{0xcb91198f, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x8fe1f29c, "If the enemy is within range, then so are you."},
+	{0x8a7567ce, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x10b4ab4e, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x53a65cb4, "C is as portable as Stonehedge!!"},
+	{0xe2ae464f, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x7ce3cd5c, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xfec6d793, "How can you write a big system without C++?  -Paul Glick"},
+}
+
+var goldenJS = []_Golden{
+	{0x4e67c6a7, ""},
+	{0xaef5004d, "a"},
+	{0xa4a84a58, "ab"},
+	{0x1a9b17a1, "abc"},
+	{0x4092adcd, "abcd"},
+	{0x62e8c8b5, "abcde"},
+	{0x173b8186, "abcdef"},
+	{0xfa04900e, "abcdefg"},
+	{0x8517b625, "abcdefgh"},
+	{0x412b04b7, "abcdefghi"},
+	{0x74805cc0, "abcdefghij"},
+	{0x914ff945, "Discard medicine more than two years old."},
+	{0x45d9a5f7, "He who has a shady past knows that nice guys finish last."},
+	{0x32e88e69, "I wouldn't marry him with a ten foot pole."},
+	{0xc5fbca2e, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x4a3eb4a4, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x34164985, "Nepal premier won't resign."},
+	{0xd372ce35, "For every action there is an equal and opposite government program."},
+	{0x452478c7, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xd917aea2, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x4955040a, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x9ebcb59b, "size:  a.out:  bad magic"},
+	{0x203f237f, "The major problem is with sendmail.  -Mark Horton"},
+	{0x94d7a7c5, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xf9c0c1d4, "If the enemy is within range, then so are you."},
+	{0xeabe8826, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xb3d22377, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x72a16729, "C is as portable as Stonehedge!!"},
+	{0xd634b1b0, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x9dc5bd70, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xe503e13d, "How can you write a big system without C++?  -Paul Glick"},
+}
+
+var goldenPJW = []_Golden{
+	{0x00000000, ""},
+	{0x00000061, "a"},
+	{0x00000672, "ab"},
+	{0x00006783, "abc"},
+	{0x00067894, "abcd"},
+	{0x006789a5, "abcde"},
+	{0x06789ab6, "abcdef"},
+	{0x0789aba7, "abcdefg"},
+	{0x089abaa8, "abcdefgh"},
+	{0x09abaa69, "abcdefghi"},
+	{0x0abaa66a, "abcdefghij"},
+	{0x0ab8c77e, "Discard medicine more than two years old."},
+	{0x0c2895ee, "He who has a shady past knows that nice guys finish last."},
+	{0x0d88846e, "I wouldn't marry him with a ten foot pole."},
+	{0x00f84415, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x0ffe12f4, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x0ce8fd4e, "Nepal premier won't resign."},
+	{0x0db274ae, "For every action there is an equal and opposite government program."},
+	{0x00bd1fee, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x0c80df37, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x0b49043b, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x04724b83, "size:  a.out:  bad magic"},
+	{0x02955e6e, "The major problem is with sendmail.  -Mark Horton"},
+	{0x035111fe, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x0a07b02e, "If the enemy is within range, then so are you."},
+	{0x0c2c655e, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x0e8fc43e, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x02450da1, "C is as portable as Stonehedge!!"},
+	{0x03568a09, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x0aa09cd5, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x0810f11b, "How can you write a big system without C++?  -Paul Glick"},
+}
+
+var goldenBKDR = []_Golden{
+	{0x00000000, ""},
+	{0x00000061, "a"},
+	{0x00003205, "ab"},
+	{0x001998f2, "abc"},
+	{0x0d19443a, "abcd"},
+	{0xb3edea13, "abcde"},
+	{0x12bec81f, "abcdef"},
+	{0x97a06844, "abcdefg"},
+	{0x97155b34, "abcdefgh"},
+	{0x4fedac05, "abcdefghi"},
+	{0xe69f06f9, "abcdefghij"},
+	{0x416d186d, "Discard medicine more than two years old."},
+	{0x0aaf7fc8, "He who has a shady past knows that nice guys finish last."},
+	{0x3a1cb442, "I wouldn't marry him with a ten foot pole."},
+	{0x66d45f1e, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xd22b0a29, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x79bad971, "Nepal premier won't resign."},
+	{0x2f0ff9ac, "For every action there is an equal and opposite government program."},
+	{0xc0511215, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x880f1666, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x4c9720df, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x5641aacc, "size:  a.out:  bad magic"},
+	{0x67c8788e, "The major problem is with sendmail.  -Mark Horton"},
+	{0x9bebfd3f, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xfa098890, "If the enemy is within range, then so are you."},
+	{0x00c93c12, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xf416b95c, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x716fb63a, "C is as portable as Stonehedge!!"},
+	{0x486f3311, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x0c2ea9f4, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x8ac4d345, "How can you write a big system without C++?  -Paul Glick"},
+}
+
+var goldenDEK = []_Golden{
+	{0x00000000, ""},
+	{0x00000041, "a"},
+	{0x00000442, "ab"},
+	{0x00000823, "abc"},
+	{0x00710404, "abcd"},
+	{0x0c2080e5, "abcde"},
+	{0x44101cc7, "abcdef"},
+	{0x82039887, "abcdefg"},
+	{0x40731f98, "abcdefgh"},
+	{0x0e63d361, "abcdefghi"},
+	{0xcc766c4b, "abcdefghij"},
+	{0x4cd1d61a, "Discard medicine more than two years old."},
+	{0xd5fbce2d, "He who has a shady past knows that nice guys finish last."},
+	{0x211d3096, "I wouldn't marry him with a ten foot pole."},
+	{0x22916cde, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xe2544f35, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x41e0fbb9, "Nepal premier won't resign."},
+	{0x5824f060, "For every action there is an equal and opposite government program."},
+	{0xfbe83868, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xb966c062, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xf23aad2f, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x8487a932, "size:  a.out:  bad magic"},
+	{0xcf10a733, "The major problem is with sendmail.  -Mark Horton"},
+	{0x5d5441e4, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x9c11ab4e, "If the enemy is within range, then so are you."},
+	{0x9601ddd0, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x3c16358f, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xb285874e, "C is as portable as Stonehedge!!"},
+	{0xef207fbb, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x265aac52, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x3b823d04, "How can you write a big system without C++?  -Paul Glick"},
+}
+
+var goldenBP = []_Golden{
+	{0x00000000, ""},
+	{0x00000061, "a"},
+	{0x000030e2, "ab"},
+	{0x00187163, "abc"},
+	{0x0c38b1e4, "abcd"},
+	{0x1c58f265, "abcde"},
+	{0x2c7932e6, "abcdef"},
+	{0x3c997367, "abcdefg"},
+	{0x4cb9b3e8, "abcdefgh"},
+	{0x5cd9f469, "abcdefghi"},
+	{0x6cfa34ea, "abcdefghij"},
+	{0x0dfb322e, "Discard medicine more than two years old."},
+	{0xcc3cfa2e, "He who has a shady past knows that nice guys finish last."},
+	{0x0dfb32ae, "I wouldn't marry him with a ten foot pole."},
+	{0x3d187b65, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x0e187964, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x3d39f72e, "Nepal premier won't resign."},
+	{0x7e5876ae, "For every action there is an equal and opposite government program."},
+	{0xdd3bb2ae, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x062e5bb7, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xec7a32eb, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xdc39f4e3, "size:  a.out:  bad magic"},
+	{0xfe5d37ee, "The major problem is with sendmail.  -Mark Horton"},
+	{0x3e9bf7ee, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x0f3bfaae, "If the enemy is within range, then so are you."},
+	{0x5c3b79ae, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x9ef87cae, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x4cf950a1, "C is as portable as Stonehedge!!"},
+	{0x5f1b32f9, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x0a5d7665, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x7d9a71eb, "How can you write a big system without C++?  -Paul Glick"},
+}
+
+var goldenAP = []_Golden{
+	{0xaaaaaaaa, ""},
+	{0xeaaaaa9f, "a"},
+	{0x49ff1856, "ab"},
+	{0x25c7ff88, "abc"},
+	{0x9b127fef, "abcd"},
+	{0xb7e406be, "abcde"},
+	{0x6deee912, "abcdef"},
+	{0x1df998bc, "abcdefg"},
+	{0x2fb3cbee, "abcdefgh"},
+	{0x8441e8ab, "abcdefghi"},
+	{0x68d9707b, "abcdefghij"},
+	{0x16cea7a5, "Discard medicine more than two years old."},
+	{0xb46a5846, "He who has a shady past knows that nice guys finish last."},
+	{0x2e25b14d, "I wouldn't marry him with a ten foot pole."},
+	{0xadf4e18b, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x32d58847, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x1ab3b68f, "Nepal premier won't resign."},
+	{0xdbedd45d, "For every action there is an equal and opposite government program."},
+	{0x6be9d2ab, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x959fd67a, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x5c1662e5, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x5b256f6c, "size:  a.out:  bad magic"},
+	{0x8ee5708d, "The major problem is with sendmail.  -Mark Horton"},
+	{0xfc7dc6ff, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x66b334f4, "If the enemy is within range, then so are you."},
+	{0x2a265e28, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xbb9c6bab, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x52c0ea49, "C is as portable as Stonehedge!!"},
+	{0x72ee7154, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xf9f622e6, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x59ae15a2, "How can you write a big system without C++?  -Paul Glick"},
+}
+
+var goldenKR1 = []_Golden{
+	{0x00000000, ""},
+	{0x00000061, "a"},
+	{0x000000c3, "ab"},
+	{0x00000126, "abc"},
+	{0x0000018a, "abcd"},
+	{0x000001ef, "abcde"},
+	{0x00000255, "abcdef"},
+	{0x000002bc, "abcdefg"},
+	{0x00000324, "abcdefgh"},
+	{0x0000038d, "abcdefghi"},
+	{0x000003f7, "abcdefghij"},
+	{0x00000f01, "Discard medicine more than two years old."},
+	{0x00001476, "He who has a shady past knows that nice guys finish last."},
+	{0x00000ee0, "I wouldn't marry him with a ten foot pole."},
+	{0x00001314, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x0000147f, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x000009e9, "Nepal premier won't resign."},
+	{0x000018fc, "For every action there is an equal and opposite government program."},
+	{0x00001461, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x00001e62, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x00001a69, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x000007de, "size:  a.out:  bad magic"},
+	{0x00001170, "The major problem is with sendmail.  -Mark Horton"},
+	{0x00001903, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x0000102a, "If the enemy is within range, then so are you."},
+	{0x000018e6, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x00001746, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x00000b08, "C is as portable as Stonehedge!!"},
+	{0x00001dcf, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x0000304e, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x00001315, "How can you write a big system without C++?  -Paul Glick"},
+}
+
+var goldenKR2 = []_Golden{
+	{0x00000000, ""},
+	{0x00000061, "a"},
+	{0x00000c21, "ab"},
+	{0x00017862, "abc"},
+	{0x002d9442, "abcd"},
+	{0x0584f463, "abcde"},
+	{0xab199863, "abcdef"},
+	{0xb8197464, "abcdefg"},
+	{0x4b151884, "abcdefgh"},
+	{0x178df865, "abcdefghi"},
+	{0xda3114a5, "abcdefghij"},
+	{0x507cbe5d, "Discard medicine more than two years old."},
+	{0xcf8332bc, "He who has a shady past knows that nice guys finish last."},
+	{0x94ddaa0e, "I wouldn't marry him with a ten foot pole."},
+	{0xd1a67f32, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x29e1993d, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x46b8e871, "Nepal premier won't resign."},
+	{0x80a347dc, "For every action there is an equal and opposite government program."},
+	{0xb560b45d, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x123c79c6, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x3f1ff283, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xbf045f20, "size:  a.out:  bad magic"},
+	{0x30642382, "The major problem is with sendmail.  -Mark Horton"},
+	{0xf11f3607, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xb68626c4, "If the enemy is within range, then so are you."},
+	{0x872d8aba, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xd68213e8, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xd55e6f3e, "C is as portable as Stonehedge!!"},
+	{0xb34d3565, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x1f5a0d48, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xda3df8dd, "How can you write a big system without C++?  -Paul Glick"},
+}
+
 var goldenJenkins = []_Golden{
 	{0x00000000, ""},
 	{0xca2e9442, "a"},
@@ -322,6 +782,41 @@ var goldenSuperfast = []_Golden{
 	{0x494c35dd, "How can you write a big system without C++?  -Paul Glick"},
 }
 
+// generated from the reference C code, which seeds the hash with the length
+var goldenSuperFastHash = []_Golden{
+	{0x00000000, ""},
+	{0x115ea782, "a"},
+	{0x516b8b44, "ab"},
+	{0xd2be198a, "abc"},
+	{0xdad8b8db, "abcd"},
+	{0x51ed072e, "abcde"},
+	{0x963b9dda, "abcdef"},
+	{0xf071c3ed, "abcdefg"},
+	{0xbe0f7428, "abcdefgh"},
+	{0x6f56e0d8, "abcdefghi"},
+	{0x873c464f, "abcdefghij"},
+	{0xe363a175, "Discard medicine more than two years old."},
+	{0xda115de4, "He who has a shady past knows that nice guys finish last."},
+	{0x8f185e4e, "I wouldn't marry him with a ten foot pole."},
+	{0x476243d4, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x25d298d9, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xe2699a14, "Nepal premier won't resign."},
+	{0x78aad65d, "For every action there is an equal and opposite government program."},
+	{0xc0896127, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x01d5eee6, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xc1d871d2, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x3f033b0d, "size:  a.out:  bad magic"},
+	{0x01d4acaa, "The major problem is with sendmail.  -Mark Horton"},
+	{0xd19751fc, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x0a4c92d2, "If the enemy is within range, then so are you."},
+	{0x9aa844fa, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xf7baacef, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x98b93fd0, "C is as portable as Stonehedge!!"},
+	{0x73a53ff5, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xd7628fa0, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xd8712cb0, "How can you write a big system without C++?  -Paul Glick"},
+}
+
 var goldenMarvin = []_Golden{
 	{0xf7f2c954, ""},
 	{0xd46e71f7, "a"},
@@ -356,181 +851,4578 @@ var goldenMarvin = []_Golden{
 	{0xd9440105, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func TestJava(t *testing.T) {
-	testGolden(t, NewJava32(), goldenJava, "java")
+var goldenMarvin64 = []_Golden64{
+	{0x3aa7450acd558c5e, ""},
+	{0xff3e84632b50f594, "a"},
+	{0x8fcb7cc43bc719d8, "ab"},
+	{0x955e077ece65c543, "abc"},
+	{0x83d47081e8c195fa, "abcd"},
+	{0x67e00c5b07fe62f3, "abcde"},
+	{0x7d1758b8810fe594, "abcdef"},
+	{0x9a727a32e3c261c9, "abcdefg"},
+	{0xe8313253bc48006b, "abcdefgh"},
+	{0x54177cd0bfeefd41, "abcdefghi"},
+	{0x598f543931275424, "abcdefghij"},
+	{0x486495262df590e7, "Discard medicine more than two years old."},
+	{0xe695fa78ed0d4965, "He who has a shady past knows that nice guys finish last."},
+	{0x4a40726ff0a10ef5, "I wouldn't marry him with a ten foot pole."},
+	{0x94019e970e2801fe, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x6f9ea03cdbfd7738, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x462682a5a0231efa, "Nepal premier won't resign."},
+	{0xaba195d8167562aa, "For every action there is an equal and opposite government program."},
+	{0x45718b9f57def541, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x4eb7f8a84f5e3240, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x392f2c1cf247122f, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x3082afb110f6544b, "size:  a.out:  bad magic"},
+	{0x1b54dcb349ff6aa6, "The major problem is with sendmail.  -Mark Horton"},
+	{0x1187a31d4bd7340c, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x6bcdfa3e92b2a84d, "If the enemy is within range, then so are you."},
+	{0x0de243ef09768324, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x5a0e48ec2b5eeb2c, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x41be97fa844bf3ca, "C is as portable as Stonehedge!!"},
+	{0xfc171cad8d3cd3ac, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xd6ac81c03b78cc26, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xe6c1f1fe3f85f0fb, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func TestDbj32(t *testing.T) {
-	testGolden(t, NewDjb32(), goldenDjb32, "djb")
+var goldenMurmur3_x86_128 = []_Golden128{
+	{0x0000000000000000, 0x0000000000000000, ""},
+	{0x5556b01ba794933c, 0x5556b01b5556b01b, "a"},
+	{0x25be3010158451df, 0x25be301025be3010, "ab"},
+	{0xa2b006a575cdc6d1, 0xa2b006a5a2b006a5, "abc"},
+	{0x45afc62e96b6ccaa, 0x45afc62e45afc62e, "abcd"},
+	{0x5d24c5bcc5402efb, 0x5a7201775a720177, "abcde"},
+	{0xa1af2721e17cb90a, 0xa9bedff9a9bedff9, "abcdef"},
+	{0x09863ade90b541d9, 0x4a7769284a776928, "abcdefg"},
+	{0xadb11487aef41136, 0xfa6c8092fa6c8092, "abcdefgh"},
+	{0x2206596fad058c1c, 0xdd94417c14d27d10, "abcdefghi"},
+	{0x9a37900ef5d92ea7, 0x692ff17fc792aa2a, "abcdefghij"},
+	{0x17f43044ce46a34c, 0x62c00bb6a0a75db5, "Discard medicine more than two years old."},
+	{0x38c748d30e954f91, 0xe57d57381022d775, "He who has a shady past knows that nice guys finish last."},
+	{0xc6a072ff097e4372, 0x26b00b169b9eee36, "I wouldn't marry him with a ten foot pole."},
+	{0x885f6803953308d0, 0x9bba2b9a975514cf, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xcdaebd87d9f0c7f6, 0xa9bd341084c02c44, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x5858651596fa5e81, 0x46d63f8d8e6a07ef, "Nepal premier won't resign."},
+	{0x9964536857ab12cb, 0xc9f199cb7eb62c58, "For every action there is an equal and opposite government program."},
+	{0x27b2fc4c9d1c76aa, 0x6c5d8ea628cc5955, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x8e006dae58176a79, 0x6d0a0ae79adf41fc, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x7054d329814b0b6a, 0x72d81487dae24b80, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x496b46eb68f3dbab, 0x13e70e88becedf0e, "size:  a.out:  bad magic"},
+	{0x2eb4ebff07ce406d, 0x1ca5eaed6dc54914, "The major problem is with sendmail.  -Mark Horton"},
+	{0xbcd45e444ff3f188, 0x0d4750d4380d9b46, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xce3677d7875187bb, 0xf66ff839ddd10fe7, "If the enemy is within range, then so are you."},
+	{0x0b6f5496adbcd3a5, 0xbad6e16343839321, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x99ac2306d9077a1d, 0xad389164026457d0, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x5c3cdaf98168820c, 0xf403df110fb1c988, "C is as portable as Stonehedge!!"},
+	{0x0af137dbed792be5, 0x86b96b3c0c5cde7c, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x9d01134b5c37e83e, 0x856024bb54c84781, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x02f8efba3f39a2bc, 0x74b1e8269f913935, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func TestDbj32a(t *testing.T) {
-	testGolden(t, NewDjb32a(), goldenDjb32a, "djb2a")
+var goldenMurmur3_x64_128 = []_Golden128{
+	{0x0000000000000000, 0x0000000000000000, ""},
+	{0x85555565f6597889, 0xe6b53a48510e895a, "a"},
+	{0x938b11ea16ed1b2e, 0xe65ea7019b52d4ad, "ab"},
+	{0xb4963f3f3fad7867, 0x3ba2744126ca2d52, "abc"},
+	{0xb87bb7d64656cd4f, 0xf2003e886073e875, "abcd"},
+	{0x2036d091f496bbb8, 0xc5c7eea04bcfec8c, "abcde"},
+	{0xe47d86bfaca3bf55, 0xb07109993321845c, "abcdef"},
+	{0xa6cd2f9fc09ee499, 0x1c3aa23ab155bbb6, "abcdefg"},
+	{0xcc8a0ab037ef8c02, 0x48890d60eb6940a1, "abcdefgh"},
+	{0x0547c0cff13c7964, 0x79b53df5b741e033, "abcdefghi"},
+	{0xb6c15b0d772f8c99, 0xa24d85dc8c651ac9, "abcdefghij"},
+	{0x5873fa15390b6d25, 0x9e5286ed7ea0a05f, "Discard medicine more than two years old."},
+	{0x32047230c60114e3, 0x3ba72d945f86474a, "He who has a shady past knows that nice guys finish last."},
+	{0x076c0ef8ccf9ae8e, 0x205d70fa0b2c7745, "I wouldn't marry him with a ten foot pole."},
+	{0x1dab72dfbc531f9b, 0x89df4fbce01c40c9, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x8de1a40bf485eb1d, 0xae242f748d1ea55c, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xd7ae7f4bb61068f2, 0x3a5730015bbd8359, "Nepal premier won't resign."},
+	{0xa90e585dfdee2088, 0x382b4b5c7bcdd171, "For every action there is an equal and opposite government program."},
+	{0x1d286c439954efa2, 0x894b2a27dbe364c5, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x09e7b8af3720a761, 0xcd6f4e8a696d0f90, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xd67d4ff6a4271096, 0x91b6ba8f6ba3dfdc, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x2a4146b5b8e74add, 0x98501e5b0ea1e60e, "size:  a.out:  bad magic"},
+	{0x329e03e6419803cb, 0x2259f2e0608d70f6, "The major problem is with sendmail.  -Mark Horton"},
+	{0x4a804c2345f6350a, 0x972ff5e8e2e64dc3, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x843c98ec40eb2575, 0x187632193630907c, "If the enemy is within range, then so are you."},
+	{0xcbfbae5c964de529, 0x807efcca5da0f56b, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x46f146dcfca49fa4, 0x91e68466360e32a2, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xd26f09531c7426ab, 0x1262b726441f2863, "C is as portable as Stonehedge!!"},
+	{0x9990fc44d9fdf481, 0x6e8e9d0f6e11ad2e, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x832bcf0f1687e1c8, 0x1d31c318d15b034c, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x568e06926f570375, 0xcd8e7cfb3c1f7167, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func TestElf32(t *testing.T) {
-	testGolden(t, NewElf32(), goldenElf32, "elf32")
+var goldenMurmur2 = []_Golden{
+	{0x00000000, ""},
+	{0x92685f5e, "a"},
+	{0x1aa14063, "ab"},
+	{0x13577c9b, "abc"},
+	{0x26873021, "abcd"},
+	{0x5f09a8de, "abcde"},
+	{0x4bc8e979, "abcdef"},
+	{0xf9a1cef3, "abcdefg"},
+	{0x042287e6, "abcdefgh"},
+	{0xf0319aa7, "abcdefghi"},
+	{0x4b09c914, "abcdefghij"},
+	{0x7b0bc078, "Discard medicine more than two years old."},
+	{0xe6634d68, "He who has a shady past knows that nice guys finish last."},
+	{0x8427897f, "I wouldn't marry him with a ten foot pole."},
+	{0x328293f8, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x9cf34ae6, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xbb1b116c, "Nepal premier won't resign."},
+	{0x2c561a5c, "For every action there is an equal and opposite government program."},
+	{0x063d1713, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xfee08e7f, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x83f4c918, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xb357b441, "size:  a.out:  bad magic"},
+	{0xe2de365d, "The major problem is with sendmail.  -Mark Horton"},
+	{0xeffef373, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x08b6c6f8, "If the enemy is within range, then so are you."},
+	{0x1c75abd1, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x955b734d, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x294ed5dd, "C is as portable as Stonehedge!!"},
+	{0xdf1ecfe0, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x1f0ccb9c, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x8a307bb6, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func TestSDBM(t *testing.T) {
-	testGolden(t, NewSDBM32(), goldenSdbm, "sdbm")
+var goldenMurmur2A = []_Golden{
+	{0x00000000, ""},
+	{0x0803888b, "a"},
+	{0x618515af, "ab"},
+	{0x11589f67, "abc"},
+	{0x5c193c47, "abcd"},
+	{0x3254454d, "abcde"},
+	{0xe140bde4, "abcdef"},
+	{0x362d0a55, "abcdefg"},
+	{0x70a13d30, "abcdefgh"},
+	{0xc6578446, "abcdefghi"},
+	{0x3240af5e, "abcdefghij"},
+	{0x4a3a7d9b, "Discard medicine more than two years old."},
+	{0x220ddaff, "He who has a shady past knows that nice guys finish last."},
+	{0x0da36f58, "I wouldn't marry him with a ten foot pole."},
+	{0xd33034f4, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x7de8c9aa, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x03c5da68, "Nepal premier won't resign."},
+	{0xf8ff86a7, "For every action there is an equal and opposite government program."},
+	{0x585294a5, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xf34cf8ba, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x0b5a350b, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x97658479, "size:  a.out:  bad magic"},
+	{0xf55dece6, "The major problem is with sendmail.  -Mark Horton"},
+	{0x49aa37da, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xbaf32839, "If the enemy is within range, then so are you."},
+	{0x6725a2df, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x5a3ecc21, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x76e1a44e, "C is as portable as Stonehedge!!"},
+	{0x550b63b1, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xd50aebde, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x0da8934e, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func TestSqlite3(t *testing.T) {
-	testGolden(t, NewSQLite32(), goldenSqlite, "sqlite3")
+var goldenMurmur64A = []_Golden64{
+	{0x0000000000000000, ""},
+	{0x071717d2d36b6b11, "a"},
+	{0x62be85b2fe53d1f8, "ab"},
+	{0x9cc9c33498a95efb, "abc"},
+	{0xec1044c45cc5097a, "abcd"},
+	{0x1182974836d6dbb7, "abcde"},
+	{0xb78e3425fc996779, "abcdef"},
+	{0x241aa52b0a62005d, "abcdefg"},
+	{0xafdb0257ff41aa98, "abcdefgh"},
+	{0xc9b9d84356146ac2, "abcdefghi"},
+	{0xa33f0dbf245bcb51, "abcdefghij"},
+	{0x4bc3a61f5ea50166, "Discard medicine more than two years old."},
+	{0x651b0bb2bf9563b4, "He who has a shady past knows that nice guys finish last."},
+	{0x5fdc184a8f2d2f77, "I wouldn't marry him with a ten foot pole."},
+	{0x8499fd1c5e9ba065, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xfde6be259cb76dda, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x48675b33e639a960, "Nepal premier won't resign."},
+	{0x32e75e0264d3e877, "For every action there is an equal and opposite government program."},
+	{0x88a7c49e7ad505c5, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x3f5d1130f299a4a3, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xb74880c51e6f4a15, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xb546b6dd7421fc9b, "size:  a.out:  bad magic"},
+	{0x90c7638a4b3ccb15, "The major problem is with sendmail.  -Mark Horton"},
+	{0x2e149e553f4f0f8b, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x138e4f346648206b, "If the enemy is within range, then so are you."},
+	{0xdcdb5523f03b9719, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x39df9e5876bc2b7e, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x621323528985b34d, "C is as portable as Stonehedge!!"},
+	{0xe4b5aa98c4a85761, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xf6e0a19a81771533, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x701445462d5c2d17, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func TestJenkins(t *testing.T) {
-	testGolden(t, NewJenkins32(), goldenJenkins, "jenkins")
+var goldenMurmur64B = []_Golden64{
+	{0x0000000000000000, ""},
+	{0x716e41e3dff50b85, "a"},
+	{0x1d910277448b4326, "ab"},
+	{0xa60d4251ce5c599d, "abc"},
+	{0x605322fe8fc31704, "abcd"},
+	{0xf2320b797c560b02, "abcde"},
+	{0x249e1e5d575ec53a, "abcdef"},
+	{0x4d78ec8050e7f569, "abcdefg"},
+	{0xbb685213f4907995, "abcdefgh"},
+	{0xc7f119fa4f515136, "abcdefghi"},
+	{0xdbff398b66d28e58, "abcdefghij"},
+	{0x05105bcacfff3380, "Discard medicine more than two years old."},
+	{0xbe66e5b6a0e0d4a1, "He who has a shady past knows that nice guys finish last."},
+	{0xe803776deee548b0, "I wouldn't marry him with a ten foot pole."},
+	{0x5fb9b69b1ce9a36b, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xcd1a0a507ea91fda, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x40c750775aa59f13, "Nepal premier won't resign."},
+	{0x76209337af6b0cfc, "For every action there is an equal and opposite government program."},
+	{0xf563cfe055c40c79, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xdd3e8af596859601, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x2e13cd5527ccd598, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x2352a5d4af588424, "size:  a.out:  bad magic"},
+	{0x235ce023fe658bdf, "The major problem is with sendmail.  -Mark Horton"},
+	{0xa97824d6b75da4a6, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xe63cc77febb625aa, "If the enemy is within range, then so are you."},
+	{0x755d9528683ce2b1, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x7b766cc7e80f4b20, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x6b6f3aa44ef1ab9f, "C is as portable as Stonehedge!!"},
+	{0xaea1946e2629b934, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x4a08fd2d40c47fa2, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xa7a8ab04e7edccf2, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func TestMurmur(t *testing.T) {
+var goldenXXH32 = []_Golden{
+	{0x02cc5d05, ""},
+	{0x550d7456, "a"},
+	{0x4999fc53, "ab"},
+	{0x32d153ff, "abc"},
+	{0xa3643705, "abcd"},
+	{0x9738f19b, "abcde"},
+	{0x8b7cd587, "abcdef"},
+	{0x9dd093b3, "abcdefg"},
+	{0x0bb3c6bb, "abcdefgh"},
+	{0xd03c13fd, "abcdefghi"},
+	{0x8b988cfe, "abcdefghij"},
+	{0x44314864, "Discard medicine more than two years old."},
+	{0xb7369878, "He who has a shady past knows that nice guys finish last."},
+	{0x5898fd4d, "I wouldn't marry him with a ten foot pole."},
+	{0x7bce2b47, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x4f6b128c, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x20278394, "Nepal premier won't resign."},
+	{0x8279eef6, "For every action there is an equal and opposite government program."},
+	{0x2446d51b, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x24bd8e4c, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x008216d0, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xf8c8ddc7, "size:  a.out:  bad magic"},
+	{0x1eb42f9d, "The major problem is with sendmail.  -Mark Horton"},
+	{0x94eb444d, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xfa295068, "If the enemy is within range, then so are you."},
+	{0xb3d5e1ad, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xe391e2a1, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xc18c1acf, "C is as portable as Stonehedge!!"},
+	{0xeccba8c8, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x1c3be085, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x77d5c9c6, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	// test the incremental hashing logic
-	m := NewMurmur3_x86_32()
+var goldenXXH64 = []_Golden64{
+	{0xef46db3751d8e999, ""},
+	{0xd24ec4f1a98c6e5b, "a"},
+	{0x65f708ca92d04a61, "ab"},
+	{0x44bc2cf5ad770999, "abc"},
+	{0xde0327b0d25d92cc, "abcd"},
+	{0x07e3670c0c8dc7eb, "abcde"},
+	{0xfa8afd82c423144d, "abcdef"},
+	{0x1860940e2902822d, "abcdefg"},
+	{0x3ad351775b4634b7, "abcdefgh"},
+	{0x27f1a34fdbb95e13, "abcdefghi"},
+	{0xd6287a1de5498bb2, "abcdefghij"},
+	{0x32740dc06f97c972, "Discard medicine more than two years old."},
+	{0x208697e054dcc560, "He who has a shady past knows that nice guys finish last."},
+	{0xbbd95d4a82689c24, "I wouldn't marry him with a ten foot pole."},
+	{0xf253c441f6b47a5f, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x4a6bbfdb48d9a15f, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x3ea3e9899e3b3a80, "Nepal premier won't resign."},
+	{0x1f20ca54f5ce156b, "For every action there is an equal and opposite government program."},
+	{0x965c6bc316da3fa3, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x7a62f82bb064224b, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x7e32f5feb5874acf, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x80e54e74e4dfbf22, "size:  a.out:  bad magic"},
+	{0x1a8fee6197321501, "The major problem is with sendmail.  -Mark Horton"},
+	{0x38c6b4ac6e4fc7b1, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x2c7830bc61c42791, "If the enemy is within range, then so are you."},
+	{0xd1ba8bda5dcfa025, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x4ffa4ccc3d10b367, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xcb948213637a4bcf, "C is as portable as Stonehedge!!"},
+	{0xeb49188936f54f24, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xc8d969ddc5fefc58, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xc23e1c546ec8e438, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	testIncremental(t, m, 0xe0c9df28, "murmur3")
+var goldenXXH3_64 = []_Golden64{
+	{0x2d06800538d394c2, ""},
+	{0xe6c632b61e964e1f, "a"},
+	{0xa873719c24d5735c, "ab"},
+	{0x78af5f94892f3950, "abc"},
+	{0x6497a96f53a89890, "abcd"},
+	{0x55c65158ee9e652d, "abcde"},
+	{0xda87bd32d3c47db6, "abcdef"},
+	{0x5a40dc3fd44c052f, "abcdefg"},
+	{0x6f45a76842a96483, "abcdefgh"},
+	{0xe0dde4fc174590a0, "abcdefghi"},
+	{0xc85ff0a489414010, "abcdefghij"},
+	{0x6c834324f93ac56d, "Discard medicine more than two years old."},
+	{0xde08acec2af5a76c, "He who has a shady past knows that nice guys finish last."},
+	{0x0f08fa24a9fdaa8e, "I wouldn't marry him with a ten foot pole."},
+	{0x49df99be35f3ac20, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x7d2d5d5bed6c8abe, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xf5cbbbad00eae661, "Nepal premier won't resign."},
+	{0x06076cd39f6089fd, "For every action there is an equal and opposite government program."},
+	{0x2ce8f4f4baa09183, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xe6cb1211ccdada12, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xc41c9eddbe7746ed, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xfeb474f733ae3af0, "size:  a.out:  bad magic"},
+	{0xaff951761914ee6d, "The major problem is with sendmail.  -Mark Horton"},
+	{0xcf7a967c90ccef5a, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x3aa984b001773024, "If the enemy is within range, then so are you."},
+	{0x73b2b4d57557971c, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xd56c7e49847a8426, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x02a0693cb1fd1f35, "C is as portable as Stonehedge!!"},
+	{0x2c4ee79eb106e6d4, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x2a25fd44c4d3e0cc, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x7699b55693e8b41c, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	testGolden(t, m, goldenMurmur3, "murmur3")
+var goldenXXH3_128 = []_Golden128{
+	{0x99aa06d3014798d8, 0x6001c324468d497f, ""},
+	{0xa96faf705af16834, 0xe6c632b61e964e1f, "a"},
+	{0x89c65ebc828eebac, 0xa873719c24d5735c, "ab"},
+	{0x06b05ab6733a6185, 0x78af5f94892f3950, "abc"},
+	{0x8d6b60383dfa90c2, 0x1be79eecd1b1353d, "abcd"},
+	{0x3043c78169f25c3f, 0x97d5a48ef320eec2, "abcde"},
+	{0x389197a55db2b2e4, 0xda35a6714d34f8a2, "abcdef"},
+	{0x2aafd83869a59c31, 0x3fe798c0edaa6dc6, "abcdefg"},
+	{0xdac23237af373533, 0x42b702b313880f12, "abcdefgh"},
+	{0xb43ff5bc5ff2e0ad, 0xc0646b2d7986db98, "abcdefghi"},
+	{0x9e814df2752571c7, 0xb0a8c058e69ff5a7, "abcdefghij"},
+	{0xfb7668bba3b4c293, 0x197597caf3f92c06, "Discard medicine more than two years old."},
+	{0x42fb293ebf67ba20, 0xe9ba9220797f9c59, "He who has a shady past knows that nice guys finish last."},
+	{0x62d1d457eaa9d2fd, 0xcd4bc5f0b6e6429e, "I wouldn't marry him with a ten foot pole."},
+	{0xa389db9dd08f6c8e, 0xba204c9419b845d0, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x26508e4f6d538601, 0x06e95296374177dc, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x7303eaaf38a89e60, 0x4a5e4e3436c9184d, "Nepal premier won't resign."},
+	{0xa70f6420bfe34675, 0xe7e8a67adbcfd23d, "For every action there is an equal and opposite government program."},
+	{0xd2fcb4d679a5104c, 0xc5ce81b68fff6520, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xc1392130c617ebd9, 0x48f43982da1e5ec6, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x5d11f75ac883022e, 0x5b54cf4360c4e508, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x45d0c3ce517a962b, 0x29a6219d5a965224, "size:  a.out:  bad magic"},
+	{0x37b35c86b6df1e25, 0x53766f47b094d33e, "The major problem is with sendmail.  -Mark Horton"},
+	{0x8928b5c4112646b5, 0x1a0cb6474bf19076, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x0700b754698fb1c8, 0x4bbc2dc3f07b4644, "If the enemy is within range, then so are you."},
+	{0xc791dda29fde1d84, 0xf37ca47366c2527f, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xc0965868bd32064f, 0xa817b0f8f085008f, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x60923552aa46d250, 0x8ee19ba45697d761, "C is as portable as Stonehedge!!"},
+	{0xd27855b919442685, 0xc4bb9a9b0eb0638c, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x21ce8753a7e6c383, 0x9746ca3e24ddbc8f, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x17c76c81adff4167, 0xa33063c79cbe52d0, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	// add murmur's own verification test here?
+var goldenSipHash24 = []_Golden64{
+	{0x726fdb47dd0e0e31, ""},
+	{0x2ba3e8e9a71148ca, "a"},
+	{0x042452c099a0d2f3, "ab"},
+	{0x5dbcfa53aa2007a5, "abc"},
+	{0xb00051890be927b6, "abcd"},
+	{0xa74563e1ea79b873, "abcde"},
+	{0x2a6e77e733c7c05d, "abcdef"},
+	{0xdc18e8672ed188eb, "abcdefg"},
+	{0xc329dda391d44470, "abcdefgh"},
+	{0xb2a9e1c46d9deca4, "abcdefghi"},
+	{0xb6c3b38b6c23fd9b, "abcdefghij"},
+	{0xabaed632a7c8bc42, "Discard medicine more than two years old."},
+	{0xc06211df6b434eb0, "He who has a shady past knows that nice guys finish last."},
+	{0x0c317317d3cf48ba, "I wouldn't marry him with a ten foot pole."},
+	{0x836b21706d0c6978, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xa92939982a5ec13d, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x1c30fd14b4d02156, "Nepal premier won't resign."},
+	{0x1f2119427a5322ba, "For every action there is an equal and opposite government program."},
+	{0x5159f7353c6690dc, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x0fa6bbbeb8d9ac9c, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x62944c42f3de9ac9, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x37fd28aa49b2289e, "size:  a.out:  bad magic"},
+	{0x3ae5ab0b631fef13, "The major problem is with sendmail.  -Mark Horton"},
+	{0x6675d01d98fc8a5d, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x486a71b0c6ee34c4, "If the enemy is within range, then so are you."},
+	{0x6c370d9bf7a64c7f, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xcea6078cf7d36c1f, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x6b30d57e86906e77, "C is as portable as Stonehedge!!"},
+	{0xcafac98dfd46dea2, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x4c754d9dbb636de1, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x8d336a14c5f21f40, "How can you write a big system without C++?  -Paul Glick"},
+}
 
+var goldenSipHash13 = []_Golden64{
+	{0xabac0158050fc4dc, ""},
+	{0x1c2697ab786a6237, "a"},
+	{0x0c149f5d943a15ed, "ab"},
+	{0x6fce24e8af8146eb, "abc"},
+	{0x2b722dba445c0659, "abcd"},
+	{0x53ace3f1f252f978, "abcde"},
+	{0xb5d886816a84416e, "abcdef"},
+	{0x639b490caba831bb, "abcdefg"},
+	{0x12d8c08c2ee9e620, "abcdefgh"},
+	{0x7e02bfd36e3aa6a2, "abcdefghi"},
+	{0x5e287ab75f9d9413, "abcdefghij"},
+	{0x3703141960a881fb, "Discard medicine more than two years old."},
+	{0x12ce89a15d8b8cf8, "He who has a shady past knows that nice guys finish last."},
+	{0xba0e0a79ebf7ba66, "I wouldn't marry him with a ten foot pole."},
+	{0xa7492a7cc4036c16, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x4d71119d54d6b1f4, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xa313603dbe7302e0, "Nepal premier won't resign."},
+	{0x46dd3ec9bbfbe311, "For every action there is an equal and opposite government program."},
+	{0x84d8e0b720f46869, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xafb65cddb5a21e4f, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x2bc850dd5dcd1c7f, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x0342fbbf8fcb938f, "size:  a.out:  bad magic"},
+	{0xec8131b19ff850f7, "The major problem is with sendmail.  -Mark Horton"},
+	{0x6ba4f51cb3331f09, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x24b79dfdb5b23413, "If the enemy is within range, then so are you."},
+	{0x5674487bfab0921e, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xdecc6cdbe4297e87, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x1e2e243590fd499c, "C is as portable as Stonehedge!!"},
+	{0x51cd79f444789bbd, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x3ad690c397459164, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x776fe2261251f101, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func TestSuperFastHash(t *testing.T) {
+var goldenSipHash24_128 = []_Golden128{
+	{0xe6a825ba047f81a3, 0x930255c71472f66d, ""},
+	{0xd921ad02fbf2a40e, 0x2664f28416c95f2d, "a"},
+	{0xf513f0d4a66ec9ca, 0x365497e41665145b, "ab"},
+	{0x3e90c913a8c396bb, 0xb8ab456061ef2533, "abc"},
+	{0xb1bc9c41f5fc09fe, 0x2f44e78e35db258d, "abcd"},
+	{0x88710d5fa96286eb, 0x1c5403dfdbcee711, "abcde"},
+	{0xae80a3413a2934c0, 0x6cf71c50b9f74e86, "abcdef"},
+	{0x1d0d3fedeaf79455, 0xdebecc76145581a6, "abcdefg"},
+	{0x96838eac01e2938c, 0xa3ed47e433317e89, "abcdefgh"},
+	{0x1caa31dc6a7b7f24, 0xd20bab7610cbc51e, "abcdefghi"},
+	{0x2fa0e005e29547a8, 0x60c84ab4f69a096f, "abcdefghij"},
+	{0x9669d60019bdcf0b, 0xcdd9b790d1ec5b61, "Discard medicine more than two years old."},
+	{0xf0ba0862edf6b766, 0x60a691c6794e2666, "He who has a shady past knows that nice guys finish last."},
+	{0x1ca7c5e2fa220246, 0x41210f8eeff98ba7, "I wouldn't marry him with a ten foot pole."},
+	{0x7a4c61b048ff9d07, 0x7c73c7b824fe459c, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x65d36827fd13daa6, 0xae9a7f079c6613c4, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x54cb46a1e40deb20, 0xb10502a819425353, "Nepal premier won't resign."},
+	{0x8c7fc9d72f377bce, 0x038d5f30d4b96937, "For every action there is an equal and opposite government program."},
+	{0x5bdf2ae072f71131, 0xdbfeb1bed9ea4252, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x668761bef9990eba, 0xc9e115db2154135c, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x1d303871a8d77caf, 0xbdd400345fd2d79a, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xe086530d7c71af7e, 0x4ef2ebaf1e11c89f, "size:  a.out:  bad magic"},
+	{0xbdcc346f27ae1e13, 0x27a0a7f493720020, "The major problem is with sendmail.  -Mark Horton"},
+	{0x7e59285d54834393, 0x335fdddc24cc8404, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xe3b0ef2cb90f2cb2, 0xb0bd9cbaa034ea2d, "If the enemy is within range, then so are you."},
+	{0xb4c4a79ff9c9aedb, 0x7dc8aec7c925a60d, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xa39566ac56af2105, 0x1a8bc42602be08bf, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xdd2846e117b15e9d, 0x1ff83917eb4587f0, "C is as portable as Stonehedge!!"},
+	{0xf3deea982caeb77b, 0xad2f7fe34ad87329, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xff2358147efdd229, 0x358a840b4fa5fc3d, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xb86234a0ee8ca2d4, 0x8eac2251b8bf23a9, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	// test the incremental hashing logic
-	m := NewSuperFastHash()
+var goldenSipHash13_128 = []_Golden128{
+	{0xbea58827b2bc7ee7, 0x013030dd6adb62fd, ""},
+	{0xa84f7c63de373c08, 0x678aae375dd72f49, "a"},
+	{0xc773f1fa7c3ad856, 0x631772a4aaa6859d, "ab"},
+	{0xe81efa46153f7543, 0x01d65f4901d5d4b2, "abc"},
+	{0x126d2cb70caef528, 0xa721fbf7cf4f756c, "abcd"},
+	{0xe942bac029570a06, 0x794500f1b24e2710, "abcde"},
+	{0x317b39187cdad0bc, 0x726c1e1b0239b268, "abcdef"},
+	{0x3e172b76d40bc7df, 0x99485dcfcae9e9cc, "abcdefg"},
+	{0x46bdfc475a26a073, 0x2d95335a0f9fabc8, "abcdefgh"},
+	{0xd91adba10d9eda49, 0xe2dfabbbdbad7a91, "abcdefghi"},
+	{0xdbc0e1d2a180edba, 0x68b34d402f810b12, "abcdefghij"},
+	{0x43b1b923aa72c2a1, 0x4a2404aced7db825, "Discard medicine more than two years old."},
+	{0xfdfd3d120aeffdd5, 0x21bfb1baae50a788, "He who has a shady past knows that nice guys finish last."},
+	{0x71fbb107937723fd, 0xe40fa6feacabde23, "I wouldn't marry him with a ten foot pole."},
+	{0x6e9cccb7ddc5a80e, 0x49c7b5919afe8347, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x40e811c2b8f274c5, 0x5951ee775403253d, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xf6e99913360d99da, 0x6002b7b57da45cf2, "Nepal premier won't resign."},
+	{0xb0c81f0925cda8e9, 0x2a88e31cf231aa79, "For every action there is an equal and opposite government program."},
+	{0x6aaf11bb212c70e4, 0x777b592c426adf97, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xc96b669ee64e35b0, 0x41fbef82c52ff45e, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xc26a36d508e514f7, 0x0983f90cb68e9de9, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xed4a09c6d913dcb0, 0x5b6222262e05b000, "size:  a.out:  bad magic"},
+	{0xdf23872f5aea61da, 0x60379b7fd30464b7, "The major problem is with sendmail.  -Mark Horton"},
+	{0x60d1864dda513af9, 0x9ff5dbb74f58952a, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x342c8cf1e2161bc8, 0xb640a90afe92947e, "If the enemy is within range, then so are you."},
+	{0x52157f6aa7a365eb, 0x52a5dc5cda21af92, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xaab68d24a1cf9091, 0x735668f5009b6435, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x4f5fc6c185008b33, 0xc367e13b1f058343, "C is as portable as Stonehedge!!"},
+	{0x9b7396fc71145614, 0x847407652dd3405b, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xbf52fd4d223b5bb4, 0x3dd7e7febf8aea01, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x8d3fad805346ba08, 0x36503a1bdfe4df45, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	testIncremental(t, m, 0x54de96ed, "superfast")
+var goldenHighwayHash64 = []_Golden64{
+	{0x907a56de22c26e53, ""},
+	{0x89f8e0c37e45b0b0, "a"},
+	{0x44ba2e43f7799953, "ab"},
+	{0x7fdf90cedfe4682e, "abc"},
+	{0x0a84887ddb46964c, "abcd"},
+	{0x1772d4c09b86a4a5, "abcde"},
+	{0x7f018ff98fb97a85, "abcdef"},
+	{0x8264a898f6764f51, "abcdefg"},
+	{0xc3d52d26ad7f0983, "abcdefgh"},
+	{0xd1fa069b2fe71f07, "abcdefghi"},
+	{0x72671bede98ff5d3, "abcdefghij"},
+	{0x145f403ef637ae20, "Discard medicine more than two years old."},
+	{0xd5683865f36dceac, "He who has a shady past knows that nice guys finish last."},
+	{0x0a1e1e661a9521b6, "I wouldn't marry him with a ten foot pole."},
+	{0x94116423c3faf919, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x5b67216cf90d61c5, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x8a566cfb2189f90a, "Nepal premier won't resign."},
+	{0x697a95501bcb1305, "For every action there is an equal and opposite government program."},
+	{0x195ae5b920dcd5e8, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xb737fa2868d53ac7, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xb2d0094dae5272c5, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x11cef97641f41a38, "size:  a.out:  bad magic"},
+	{0x93dee5e78cc5856f, "The major problem is with sendmail.  -Mark Horton"},
+	{0xf834e0c80db9cd35, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xbe0aa070200ec1a5, "If the enemy is within range, then so are you."},
+	{0x945936770243c556, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x01a45a14c08627a0, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xa6bbe4609a2575ff, "C is as portable as Stonehedge!!"},
+	{0xcf0eae41a07dce89, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xd3868123b860a842, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x5636724ba2a0192e, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	testGolden(t, m, goldenSuperfast, "superfast")
+var goldenHighwayHash128 = []_Golden128{
+	{0x0fed268f9d8ffec7, 0x33565e767f093e6f, ""},
+	{0x5c66f98c849387b7, 0xad53b8e88f6ec6c6, "a"},
+	{0x3b6f563526f0c88b, 0xe2f3cb0fbe8172cc, "ab"},
+	{0x6a9cbc79f11411ed, 0x8dd602a99b86e3cb, "abc"},
+	{0xf8b74f12efa3c5a7, 0xd22150ef29f7e31f, "abcd"},
+	{0x5a1cf7d6563a50ab, 0xbd01b9a27b0f8957, "abcde"},
+	{0x208affbcef8fddad, 0xba6969151c572cad, "abcdef"},
+	{0x686fcb77d867bbc1, 0xef8b305ecd40c995, "abcdefg"},
+	{0xc5d8028082353b6e, 0x0382bc31fd4b8eb5, "abcdefgh"},
+	{0x6415843ac9d483bf, 0xefcf6d127115ec89, "abcdefghi"},
+	{0x8c9d177e02d57983, 0xa17700d39d0d826c, "abcdefghij"},
+	{0xa7ca656cc99514e0, 0x0da7f4ce7c26acd9, "Discard medicine more than two years old."},
+	{0x3a5905fb8fe8f338, 0x681e5986855b5548, "He who has a shady past knows that nice guys finish last."},
+	{0xab0ac5b5bc20d270, 0x1d9475ceab6d7b53, "I wouldn't marry him with a ten foot pole."},
+	{0x9d81515359c18154, 0x7d903fd2c5c3b6bb, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xa4cfe16594b82c7f, 0xcc4d3c1243e68646, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x3e43b4e725a7ead0, 0x48432011cd90a88a, "Nepal premier won't resign."},
+	{0x668d9d068102085d, 0xb24f519c996b82bd, "For every action there is an equal and opposite government program."},
+	{0x6c15a3cb74ad2c66, 0x0ec0929cb84c5223, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x7ec49028a29b28fe, 0x4209b8e6de98e9a6, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x8c80a7c2a2395ff0, 0xc79df5e0d71a4a3e, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x85d639e961e84e44, 0xec971604f977aed5, "size:  a.out:  bad magic"},
+	{0x85f836f5b18c094c, 0xf7e789ba308e033e, "The major problem is with sendmail.  -Mark Horton"},
+	{0xea8d9cc0358e8c65, 0x4089a8532d12aaea, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xf5ec5f0c0f0c4c23, 0x606e222cf0fdfd43, "If the enemy is within range, then so are you."},
+	{0x8ef8c0c2d9bc09ab, 0xff3854cd1bd7af36, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x2b0f790c5dfa9a48, 0x456bb497cc4f893c, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xb778d39c7c59bc2e, 0x4c0d17e9314a3615, "C is as portable as Stonehedge!!"},
+	{0xbd2a85a5e1b197ae, 0x34cf3ae43058f80c, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x075ca803a5d2feed, 0xd95224158eb5f6cb, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xe345ed52d6de1db8, 0x36d7078e628a4386, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func TestMarvin(t *testing.T) {
+var goldenHighwayHash256 = []_Golden256{
+	{0xdd44482ac2c874f5, 0xd946017313c7351f, 0xb3aebeccb98714ff, 0x41da233145751df4, ""},
+	{0xd6f10183194f8527, 0x821288c0b2311d47, 0x35cc8835c72c24d8, 0x5a00cef30f32d04a, "a"},
+	{0x131c278f0ea7600f, 0x7da5e1cb43162f1e, 0xb9328ce254673108, 0x878733a62d545e4b, "ab"},
+	{0x219b7309085919a7, 0x03a2ac3f69d0545a, 0xe10d9bfae36e20ff, 0x2c9c32b3dd3fff9f, "abc"},
+	{0x594c8834f0a50511, 0x0cf32d54dad8ca0d, 0xd72c8f049380b21f, 0x125a9dbffc2b51cf, "abcd"},
+	{0xfd848efe8e2f8eb7, 0xea137919965b7d6e, 0x6f79eb91709990d1, 0x05e7f345c83b8bd8, "abcde"},
+	{0x7e8ca8adef958a6a, 0x7cafdc69a7a53b6a, 0x7b6298903213fede, 0x31db6ad81308a590, "abcdef"},
+	{0x0d16416b14dd2caa, 0x243acc1e10982aab, 0x39146cb50011bb98, 0x4daf418cf4e565b3, "abcdefg"},
+	{0xed7749a85fff10db, 0x85fba27e6bf0f57d, 0xb274efc04e78a895, 0xb6992e4a6c9da9c3, "abcdefgh"},
+	{0x8fb18d8a9bfe7b42, 0x9c02b568819857fc, 0x25eaa209bf589c80, 0xee2562fc6d914e85, "abcdefghi"},
+	{0xce2b83aa4e960226, 0xd816def3b50dc6c3, 0x1ef3ca2425bb021c, 0xdac1d0b904a420b8, "abcdefghij"},
+	{0xa5d61c41540f172a, 0xc7f8de6f9c738e25, 0xb9748c307d239c24, 0x3f04050bd9798de6, "Discard medicine more than two years old."},
+	{0xa636c35e4fecba21, 0xa047e6d6946587c8, 0x2a12b6f9d69920f8, 0x6699f86b26cb772f, "He who has a shady past knows that nice guys finish last."},
+	{0x42bbb64b07b28463, 0x817f127b91adbdd0, 0x312aafcf64ad3161, 0xadf3bd84b8ef8b82, "I wouldn't marry him with a ten foot pole."},
+	{0x5bc32fa0221ad324, 0x2dfa1f48725c2f7d, 0xb0ba01b8e0c65040, 0x6420e23f239fb454, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x2d4691dd6a784beb, 0xcb0eff85bb07eadc, 0x6a0ae0b699289131, 0x59acc774a0109066, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xcd752b220a8f75e8, 0x0840f92554192fb5, 0xc4eeaf841eef0344, 0x71d8dc2aab1a0bb2, "Nepal premier won't resign."},
+	{0x4ac5713569326d2a, 0x44f60c7d0cf52e8a, 0x0cfffa845ed55a91, 0xf4d50a6e59e280b6, "For every action there is an equal and opposite government program."},
+	{0x80785bafc7ddf2c0, 0x90789edd63b42d01, 0xfedb7a4bc297e063, 0x7e5f40bcb0b5fd95, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xbb4b6c729f31efd6, 0xdc79c65a2209bef2, 0x1b4f13c00741fd48, 0xaeab5518ef57ccd2, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x3ab28f111546664e, 0x0664199eed2bfd30, 0xc383b364dec43c11, 0x126f9262b156a3d1, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x0413e8ba190e6717, 0x0d7ce359dcf39178, 0xa9186883b7c90cf8, 0x50998fd094139493, "size:  a.out:  bad magic"},
+	{0xee92e16ab0dc8418, 0x09cc2548767a39fc, 0x5eeff5269786da8c, 0x600fd663cb66778c, "The major problem is with sendmail.  -Mark Horton"},
+	{0x8b953f39ec30a099, 0xff79a29139543ac9, 0x07b58be8d9f74aac, 0xb221ae8da8bb34a1, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x46ad019a2284c58b, 0x269adbee91a19469, 0x25a8f5910baa465b, 0x6e8d6ec592e54704, "If the enemy is within range, then so are you."},
+	{0x0b9a2df0ebc8c914, 0x34564fff6dea9c52, 0xf6d3f9cf835b713a, 0x2ce3afea219a4301, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x60e965c514695fcf, 0x99058f768cbfd42b, 0x41ce1abed25cbe61, 0x9b6900e3695a49c4, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x2853b50552bc68ee, 0xe1b31625278c1741, 0x730ace10fb677a9f, 0x80655279c8408da2, "C is as portable as Stonehedge!!"},
+	{0xcc4b30e3c4f6e482, 0x42e9994b9227e73b, 0x44b505d1d76a3b21, 0x730c0407f3e449a8, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xe26e5a8c75be1de0, 0x8831f91259fd0aab, 0x3ce87782c9b3257a, 0xeda0e8071c15ffd4, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xcf5b0a4bf0e1ff9e, 0x2f7264d3134ab60b, 0xd8e3903d5cd4aa9a, 0x92c82a63fe3928d5, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	m := NewMarvin32(0x5D70D359C498B3F8) // seed for testing
+var goldenCityHash32 = []_Golden{
+	{0xdc56d17a, ""},
+	{0x3c973d4d, "a"},
+	{0x417330fd, "ab"},
+	{0x2f635ec7, "abc"},
+	{0x98b51e95, "abcd"},
+	{0xa3f366ac, "abcde"},
+	{0x0f813aa4, "abcdef"},
+	{0x21deb6d7, "abcdefg"},
+	{0xfd7ec8b9, "abcdefgh"},
+	{0x6f98dc86, "abcdefghi"},
+	{0xf2669361, "abcdefghij"},
+	{0x3f0f5b68, "Discard medicine more than two years old."},
+	{0x4e02096b, "He who has a shady past knows that nice guys finish last."},
+	{0x96d8f11a, "I wouldn't marry him with a ten foot pole."},
+	{0x5f4084d1, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xe5292f93, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xfdbc61f7, "Nepal premier won't resign."},
+	{0xc4b26ea1, "For every action there is an equal and opposite government program."},
+	{0x12ee6ebe, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x4a3d90de, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x87d4ae83, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xd10e8e03, "size:  a.out:  bad magic"},
+	{0x096452ba, "The major problem is with sendmail.  -Mark Horton"},
+	{0x68754c10, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xd0ae040a, "If the enemy is within range, then so are you."},
+	{0x293feb48, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xa360f17c, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xd1bee574, "C is as portable as Stonehedge!!"},
+	{0x29221a60, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x836e5d32, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x8f5f297d, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	// test the incremental hashing logic
-	testIncremental(t, m, 0x28685e7a, "marvin")
+var goldenCityHash64 = []_Golden64{
+	{0x9ae16a3b2f90404f, ""},
+	{0xb3454265b6df75e3, "a"},
+	{0xaa8d6e5242ada51e, "ab"},
+	{0x24a5b3a074e7f369, "abc"},
+	{0x1a5502de4a1f8101, "abcd"},
+	{0xc22f4663e54e04d4, "abcde"},
+	{0xc329379e6a03c2cd, "abcdef"},
+	{0x3c40c92b1ccb7355, "abcdefg"},
+	{0xfee9d22990c82909, "abcdefgh"},
+	{0x332c8ed4dae5ba42, "abcdefghi"},
+	{0x8a3abb6a5f3fb7fb, "abcdefghij"},
+	{0xcb23aa740ec1de6c, "Discard medicine more than two years old."},
+	{0xb143c7a6e24b2394, "He who has a shady past knows that nice guys finish last."},
+	{0xfbc863da329d16f0, "I wouldn't marry him with a ten foot pole."},
+	{0xf2223b6510ea0f83, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xf2dd3b530144156d, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x8eb3808d1ccfc779, "Nepal premier won't resign."},
+	{0xf8c5700b15f24497, "For every action there is an equal and opposite government program."},
+	{0xc05a6d0ee44d1649, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xc4763f54be3d2357, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x60276d0d1656e914, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x80d73b843ba57db8, "size:  a.out:  bad magic"},
+	{0xfb2ba3aabd9b6de5, "The major problem is with sendmail.  -Mark Horton"},
+	{0xf177302c46d061f9, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xf8921ce51259b0ae, "If the enemy is within range, then so are you."},
+	{0xd28e50c778711dfa, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x2cf19a7fc1433a0f, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xb944f8a16261e414, "C is as portable as Stonehedge!!"},
+	{0x1766d0c05137f186, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x70d51ee3a43213f5, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x0a660457c16aedc8, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	testGolden(t, m, goldenMarvin, "marvin")
+var goldenCityHash128 = []_Golden128{
+	{0x3df09dfc64c09a2b, 0x3cb540c392e51e29, ""},
+	{0x6e97d6bbdfc0a0c4, 0x52a71e38f43be561, "a"},
+	{0x13e834f38a6c88b8, 0xcfdbce01c0e7622e, "ab"},
+	{0x3980b2afd2126c04, 0xa085f09013029e45, "abc"},
+	{0xb8d7175e11647e82, 0x0906d778016538d9, "abcd"},
+	{0x940fcbbc468d384f, 0xf7776b2eaa1583e1, "abcde"},
+	{0x6f7c444b0a4eb3eb, 0x7da95bbe683b00b0, "abcdef"},
+	{0x9e5daa7baf7e4573, 0xcfb5d54dd0ac6959, "abcdefg"},
+	{0x60f2a826d4d614ef, 0x56f19716a4032fcb, "abcdefgh"},
+	{0x7b5fd93102612d91, 0x61aa40e4e386bd9c, "abcdefghi"},
+	{0xa2dff876385556e8, 0x0f628f07a0123c87, "abcdefghij"},
+	{0xc6c8eac0aafacfed, 0x8efcd3bd44573235, "Discard medicine more than two years old."},
+	{0x273ef578b7c1056b, 0xa872b4052ea8c636, "He who has a shady past knows that nice guys finish last."},
+	{0xea8b15a2a33e8211, 0xe23fc1fda1552993, "I wouldn't marry him with a ten foot pole."},
+	{0x4813d464644d7658, 0x7367af890cc39d36, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xcccd07c8d398e7fe, 0x8f623e32dec3f91f, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xa646f296be6c7a80, 0xc5c0c296fea38db2, "Nepal premier won't resign."},
+	{0x3e23a6e232671c25, 0x5ccc8ce07185764f, "For every action there is an equal and opposite government program."},
+	{0xe09d3113753a527c, 0xec22d95954539646, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x313bece7d506637d, 0x04e405567dabb986, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xabc0c75984ae62f2, 0x0ff3a7fb8f8419ae, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x12ab5d9fca8a7a6f, 0x26726f42f1aba3b3, "size:  a.out:  bad magic"},
+	{0x41d35389237b36e4, 0xc544a2d600ae8dfb, "The major problem is with sendmail.  -Mark Horton"},
+	{0x1cdb19fd13eccaff, 0x28db4556ceb58337, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x5595b0dbcb471e00, 0xcd5ead4e1c04dfa1, "If the enemy is within range, then so are you."},
+	{0x1082910836b47d27, 0x2f3b24ebcfa58e41, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xd7ff0e47283d075c, 0xb7663fe8a39ee896, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x1e41fc4638c4da77, 0x0896f4bd73582b3e, "C is as portable as Stonehedge!!"},
+	{0x89432ab05f44af82, 0xcdab02ccb904bcd1, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x71dc055b46107f35, 0xff33cd37f0985850, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x9813f2f5d9b33cb3, 0xc59d72687a4b9b15, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func BenchmarkJava32(b *testing.B) {
-	commonBench(b, NewJava32(), goldenJava)
+var goldenFarmFingerprint32 = []_Golden{
+	{0xdc56d17a, ""},
+	{0x3c973d4d, "a"},
+	{0x417330fd, "ab"},
+	{0x2f635ec7, "abc"},
+	{0x98b51e95, "abcd"},
+	{0xa3f366ac, "abcde"},
+	{0x0f813aa4, "abcdef"},
+	{0x21deb6d7, "abcdefg"},
+	{0xfd7ec8b9, "abcdefgh"},
+	{0x6f98dc86, "abcdefghi"},
+	{0xf2669361, "abcdefghij"},
+	{0xe273108f, "Discard medicine more than two years old."},
+	{0xf585dfc4, "He who has a shady past knows that nice guys finish last."},
+	{0x363394d1, "I wouldn't marry him with a ten foot pole."},
+	{0x7613810f, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x2cc30bb7, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x322984d9, "Nepal premier won't resign."},
+	{0xa5812ac8, "For every action there is an equal and opposite government program."},
+	{0x1090d244, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xff16c9e6, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xcc3d0ff2, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xc6246b8d, "size:  a.out:  bad magic"},
+	{0xd225e92e, "The major problem is with sendmail.  -Mark Horton"},
+	{0x1b8db5d0, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x4fda5f07, "If the enemy is within range, then so are you."},
+	{0x2e18e880, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xd07de88f, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x221694e4, "C is as portable as Stonehedge!!"},
+	{0xe2053c2c, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x11c493bb, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x0819a4e8, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func BenchmarkDJB(b *testing.B) {
-	commonBench(b, NewDjb32(), goldenDjb32)
+var goldenFarmFingerprint64 = []_Golden64{
+	{0x9ae16a3b2f90404f, ""},
+	{0xb3454265b6df75e3, "a"},
+	{0xaa8d6e5242ada51e, "ab"},
+	{0x24a5b3a074e7f369, "abc"},
+	{0x1a5502de4a1f8101, "abcd"},
+	{0xc22f4663e54e04d4, "abcde"},
+	{0xc329379e6a03c2cd, "abcdef"},
+	{0x3c40c92b1ccb7355, "abcdefg"},
+	{0xfee9d22990c82909, "abcdefgh"},
+	{0x332c8ed4dae5ba42, "abcdefghi"},
+	{0x8a3abb6a5f3fb7fb, "abcdefghij"},
+	{0xe8f89ab6df9bdd25, "Discard medicine more than two years old."},
+	{0x786d7e1987023ca9, "He who has a shady past knows that nice guys finish last."},
+	{0xa9961670ce2a46d9, "I wouldn't marry him with a ten foot pole."},
+	{0x5d14f96c18fe3d5e, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x2a578b80bb82147c, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x8eb3808d1ccfc779, "Nepal premier won't resign."},
+	{0x55182f8859eca4ce, "For every action there is an equal and opposite government program."},
+	{0xec8848fd3b266c10, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x7e85d7b050ed2967, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x38aa3175b37f305c, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x80d73b843ba57db8, "size:  a.out:  bad magic"},
+	{0xc2f8db8624fefc0e, "The major problem is with sendmail.  -Mark Horton"},
+	{0xa2b8bf3032021993, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xbdd69b798d6ba37a, "If the enemy is within range, then so are you."},
+	{0x1d85702503ac7eb4, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xabcdb319fcf2826c, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xb944f8a16261e414, "C is as portable as Stonehedge!!"},
+	{0x5a05644eb66e435e, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x098eff6958c5e91a, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x5a0a6efd52e84e2a, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func BenchmarkElf32(b *testing.B) {
-	commonBench(b, NewElf32(), goldenElf32)
+// The test table from the reference city-test.cc.  The columns are CityHash64,
+// CityHash64WithSeed, CityHash64WithSeeds, CityHash128 (low, high),
+// CityHash128WithSeed (low, high) and CityHash32; the CityHashCrc columns
+// have been dropped.
+var cityReference = [][8]uint64{
+	{0x9ae16a3b2f90404f, 0x75106db890237a4a, 0x3feac5f636039766, 0x3df09dfc64c09a2b, 0x3cb540c392e51e29, 0x6b56343feac0663, 0x5b7bc50fd8e8ad92, 0xdc56d17a},
+	{0x541150e87f415e96, 0x1aef0d24b3148a1a, 0xbacc300e1e82345a, 0xc3cdc41e1df33513, 0x2c138ff2596d42f6, 0xf58e9082aed3055f, 0x162e192b2957163d, 0x99929334},
+	{0xf3786a4b25827c1, 0x34ee1a2bf767bd1c, 0x2f15ca2ebfb631f2, 0x3149ba1dac77270d, 0x70e2e076e30703c, 0x59bcc9659bc5296, 0x9ecbc8132ae2f1d7, 0x4252edb7},
+	{0xef923a7a1af78eab, 0x79163b1e1e9a9b18, 0xdf3b2aca6e1e4a30, 0x2193fb7620cbf23b, 0x8b6a8ff06cda8302, 0x1a44469afd3e091f, 0x8b0449376612506, 0xebc34f3c},
+	{0x11df592596f41d88, 0x843ec0bce9042f9c, 0xcce2ea1e08b1eb30, 0x4d09e42f09cc3495, 0x666236631b9f253b, 0xd28b3763cd02b6a3, 0x43b249e57c4d0c1b, 0x26f2b463},
+	{0x831f448bdc5600b3, 0x62a24be3120a6919, 0x1b44098a41e010da, 0xdc07df53b949c6b, 0xd2b11b2081aeb002, 0xd212b02c1b13f772, 0xc0bed297b4be1912, 0xb042c047},
+	{0x3eca803e70304894, 0xd80de767e4a920a, 0xa51cfbb292efd53d, 0xd183dcda5f73edfa, 0x3a93cbf40f30128c, 0x1a92544d0b41dbda, 0xaec2c4bee81975e1, 0xe73bb0a8},
+	{0x1b5a063fb4c7f9f1, 0x318dbc24af66dee9, 0x10ef7b32d5c719af, 0xb140a02ef5c97712, 0xb7d00ef065b51b33, 0x635121d532897d98, 0x532daf21b312a6d6, 0x91dfdd75},
+	{0xa0f10149a0e538d6, 0x69d008c20f87419f, 0x41b36376185b3e9e, 0x26b6689960ccf81d, 0x55f23b27bb9efd94, 0x3a17f6166dd765db, 0xc891a8a62931e782, 0xc87f95de},
+	{0xfb8d9c70660b910b, 0xa45b0cc3476bff1b, 0xb28d1996144f0207, 0x98ec31113e5e35d2, 0x5e4aeb853f1b9aa7, 0xbcf5c8fe4465b7c8, 0xb1ea3a8243996f15, 0x3f5538ef},
+	{0x236827beae282a46, 0xe43970221139c946, 0x4f3ac6faa837a3aa, 0x71fec0f972248915, 0x2170ec2061f24574, 0x9eb346b6caa36e82, 0x2908f0fdbca48e73, 0x70eb1a1f},
+	{0xc385e435136ecf7c, 0xd9d17368ff6c4a08, 0x1b31eed4e5251a67, 0xdf01a322c43a6200, 0x298b65a1714b5a7e, 0x933b83f0aedf23c, 0x157bcb44d63f765a, 0xcfd63b83},
+	{0xe3f6828b6017086d, 0x21b4d1900554b3b0, 0xbef38be1809e24f1, 0xd93251758985ee6c, 0x32a9e9f82ba2a932, 0x3822aacaa95f3329, 0xdb349b2f90a490d8, 0x894a52ef},
+	{0x851fff285561dca0, 0x4d1277d73cdf416f, 0x28ccffa61010ebe2, 0x77a4ccacd131d9ee, 0xe1d08eeb2f0e29aa, 0x70b9e3051383fa45, 0x582d0120425caba, 0x9cde6a54},
+	{0x61152a63595a96d9, 0xd1a3a91ef3a7ba45, 0x443b6bb4a493ad0c, 0xa154296d11362d06, 0xd0f0bf1f1cb02fc1, 0xccb87e09309f90d1, 0xb24a8e4881911101, 0x6c4898d5},
+	{0x44473e03be306c88, 0x30097761f872472a, 0x9fd1b669bfad82d7, 0x3bab18b164396783, 0x47e385ff9d4c06f, 0x18062081bf558df, 0x63416eb68f104a36, 0x13e1978e},
+	{0x3ead5f21d344056, 0xfb6420393cfb05c3, 0x407932394cbbd303, 0xac059617f5906673, 0x94d50d3dcd3069a7, 0x2b26c3b92dea0f0, 0x99b7374cc78fc3fb, 0x51b4ba8},
+	{0x6abbfde37ee03b5b, 0x83febf188d2cc113, 0xcda7b62d94d5b8ee, 0xa4375590b8ae7c82, 0x168fd42f9ecae4ff, 0x23bbde43de2cb214, 0xa8c333112a243c8c, 0xb6b06e40},
+	{0x943e7ed63b3c080, 0x1ef207e9444ef7f8, 0xef4a9f9f8c6f9b4a, 0x6b54fc38d6a84108, 0x32f4212a47a4665, 0x6b5a9a8f64ee1da6, 0x9f74e86c6da69421, 0x240a2f2},
+	{0xd72ce05171ef8a1a, 0xc6bd6bd869203894, 0xc760e6396455d23a, 0xf86af0b40dcce7b, 0x8d3c15d613394d3c, 0x491e400491cd4ece, 0x7c19d3530ea3547f, 0x5dcefc30},
+	{0x4182832b52d63735, 0x337097e123eea414, 0xb5a72ca0456df910, 0x7ebc034235bc122f, 0xd9a7783d4edd8049, 0x5f8b04a15ae42361, 0xfc193363336453dd, 0x7a48b105},
+	{0xd6cdae892584a2cb, 0x58de0fa4eca17dcd, 0x43df30b8f5f1cb00, 0x9e4ea5a4941e097d, 0x547e048d5a9daaba, 0xeb6ecbb0b831d185, 0xe0168df5fad0c670, 0xfd55007b},
+	{0x5c8e90bc267c5ee4, 0xe9ae044075d992d9, 0xf234cbfd1f0a1e59, 0xce2744521944f14c, 0x104f8032f99dc152, 0x4e7f425bfac67ca7, 0x9461b911a1c6d589, 0x6b95894c},
+	{0xbbd7f30ac310a6f3, 0xb23b570d2666685f, 0xfb13fb08c9814fe7, 0x4ee107042e512374, 0x1e2c8c0d16097e13, 0x210c7500995aa0e6, 0x6c13190557106457, 0x3360e827},
+	{0x36a097aa49519d97, 0x8204380a73c4065, 0x77c2004bdd9e276a, 0x6ee1f817ce0b7aee, 0xe9dcb3507f0596ca, 0x6bc63c666b5100e2, 0xe0b056f1821752af, 0x45177e0b},
+	{0xdc78cb032c49217, 0x112464083f83e03a, 0x96ae53e28170c0f5, 0xd367ff54952a958, 0xcdad930657371147, 0xaa24dc2a9573d5fe, 0xeb136daa89da5110, 0x7c6fffe4},
+	{0x441593e0da922dfe, 0x936ef46061469b32, 0x204a1921197ddd87, 0x50d8a70e7a8d8f56, 0x256d150ae75dab76, 0xe81f4c4a1989036a, 0xd0f8db365f9d7e00, 0xbbc78da4},
+	{0x2ba3883d71cc2133, 0x72f2bbb32bed1a3c, 0x27e1bd96d4843251, 0xa90f761e8db1543a, 0xc339e23c09703cd8, 0xf0c6624c4b098fd3, 0x1bae2053e41fa4d9, 0xc5c25d39},
+	{0xf2b6d2adf8423600, 0x7514e2f016a48722, 0x43045743a50396ba, 0x23dacb811652ad4f, 0xc982da480e0d4c7d, 0x3a9c8ed5a399d0a9, 0x951b8d084691d4e4, 0xb6e5d06e},
+	{0x38fffe7f3680d63c, 0xd513325255a7a6d1, 0x31ed47790f6ca62f, 0xc801faaa0a2e331f, 0x491dbc58279c7f88, 0x9c0178848321c97a, 0x9d934f814f4d6a3c, 0x6178504e},
+	{0xb7477bf0b9ce37c6, 0x63b1c580a7fd02a4, 0xf6433b9f10a5dac, 0x68dd76db9d64eca7, 0x36297682b64b67, 0x42b192d71f414b7a, 0x79692cef44fa0206, 0xbd4c3637},
+	{0x55bdb0e71e3edebd, 0xc7ab562bcf0568bc, 0x43166332f9ee684f, 0xb2e25964cd409117, 0xa010599d6287c412, 0xfa5d6461e768dda2, 0xcb3ce74e8ec4f906, 0x6e7ac474},
+	{0x782fa1b08b475e7, 0xfb7138951c61b23b, 0x9829105e234fb11e, 0x9a8c431f500ef06e, 0xd848581a580b6c12, 0xfecfe11e13a2bdb4, 0x6c4fa0273d7db08c, 0x1fb4b518},
+	{0xc5dc19b876d37a80, 0x15ffcff666cfd710, 0xe8c30c72003103e2, 0x7870765b470b2c5d, 0x78a9103ff960d82, 0x7bb50ffc9fac74b3, 0x477e70ab2b347db2, 0x31d13d6d},
+	{0x5e1141711d2d6706, 0xb537f6dee8de6933, 0x3af0a1fbbe027c54, 0xea349dbc16c2e441, 0x38a7455b6a877547, 0x5f97b9750e365411, 0xe8cde7f93af49a3, 0x26fa72e3},
+	{0x782edf6da001234f, 0xf48cbd5c66c48f3, 0x808754d1e64e2a32, 0x5d9dde77353b1a6d, 0x11f58c54581fa8b1, 0xda90fa7c28c37478, 0x5e9a2eafc670a88a, 0x6a7433bf},
+	{0xd26285842ff04d44, 0x8f38d71341eacca9, 0x5ca436f4db7a883c, 0xbf41e5376b9f0eec, 0x2252d21eb7e1c0e9, 0xf4b70a971855e732, 0x40c7695aa3662afd, 0x4e6df758},
+	{0xc6ab830865a6bae6, 0x6aa8e8dd4b98815c, 0xefe3846713c371e5, 0xa1924cbf0b5f9222, 0x7f4872369c2b4258, 0xcd6da30530f3ea89, 0xb7f8b9a704e6cea1, 0xd57f63ea},
+	{0x44b3a1929232892, 0x61dca0e914fc217, 0xa607cc142096b964, 0xf7dbc8433c89b274, 0x2f5f70581c9b7d32, 0x39bf5e5fec82dcca, 0x8ade56388901a619, 0x52ef73b3},
+	{0x4b603d7932a8de4f, 0xfae64c464b8a8f45, 0x8fafab75661d602a, 0x8ffe870ef4adc087, 0x65bea2be41f55b54, 0x82f3503f636aef1, 0x5f78a282378b6bb0, 0x3cb36c3},
+	{0x4ec0b54cf1566aff, 0x30d2c7269b206bf4, 0x77c22e82295e1061, 0x3df9b04434771542, 0xfeddce785ccb661f, 0xa644aff716928297, 0xdd46aee73824b4ed, 0x72c39bea},
+	{0xed8b7a4b34954ff7, 0x56432de31f4ee757, 0x85bd3abaa572b155, 0x7d2c38a926dc1b88, 0x5245b9eb4cd6791d, 0xfb53ab03b9ad0855, 0x3664026c8fc669d7, 0xa65aa25c},
+	{0x5d28b43694176c26, 0x714cc8bc12d060ae, 0x3437726273a83fe6, 0x864b1b28ec16ea86, 0x6a78a5a4039ec2b9, 0x8e959533e35a766, 0x347b7c22b75ae65f, 0x74740539},
+	{0x6a1ef3639e1d202e, 0x919bc1bd145ad928, 0x30f3f7e48c28a773, 0x2e8c49d7c7aaa527, 0x5e2328fc8701db7c, 0x89ef1afca81f7de8, 0xb1857db11985d296, 0xc3ae3c26},
+	{0x159f4d9e0307b111, 0x3e17914a5675a0c, 0xaf849bd425047b51, 0x3b69edadf357432b, 0x3a2e311c121e6bf2, 0x380fad1e288d57e5, 0xbf7c7e8ef0e3b83a, 0xf29db8a2},
+	{0xcc0a840725a7e25b, 0x57c69454396e193a, 0x976eaf7eee0b4540, 0xcd7a46850b95e901, 0xc57f7d060dda246f, 0x6b9406ead64079bf, 0x11b28e20a573b7bd, 0x1ef4cbf4},
+	{0xa2b27ee22f63c3f1, 0x9ebde0ce1b3976b2, 0x2fe6a92a257af308, 0x8c1df927a930af59, 0xa462f4423c9e384e, 0x236542255b2ad8d9, 0x595d201a2c19d5bc, 0xa9be6c41},
+	{0xd8f2f234899bcab3, 0xb10b037297c3a168, 0xdebea2c510ceda7f, 0x9498fefb890287ce, 0xae68c2be5b1a69a6, 0x6189dfba34ed656c, 0x91658f95836e5206, 0xfa31801},
+	{0x584f28543864844f, 0xd7cee9fc2d46f20d, 0xa38dca5657387205, 0x7a0b6dbab9a14e69, 0xc6d0a9d6b0e31ac4, 0xa674d85812c7cf6, 0x63538c0351049940, 0x8331c5d8},
+	{0xa94be46dd9aa41af, 0xa57e5b7723d3f9bd, 0x34bf845a52fd2f, 0x843b58463c8df0ae, 0x74b258324e916045, 0xbdd7353230eb2b38, 0xfad31fced7abade5, 0xe9876db8},
+	{0x9a87bea227491d20, 0xa468657e2b9c43e7, 0xaf9ba60db8d89ef7, 0xcc76f429ea7a12bb, 0x5f30eaf2bb14870a, 0x434e824cb3e0cd11, 0x431a4d382e39d16e, 0x27b0604e},
+	{0x27688c24958d1a5c, 0xe3b4a1c9429cf253, 0x48a95811f70d64bc, 0x328063229db22884, 0x67e9c95f8ba96028, 0x7c6bf01c60436075, 0xfa55161e7d9030b2, 0xdcec07f2},
+	{0x5d1d37790a1873ad, 0xed9cd4bcc5fa1090, 0xce51cde05d8cd96a, 0xf72c26e624407e66, 0xa0eb541bdbc6d409, 0xc3f40a2f40b3b213, 0x6a784de68794492d, 0xcff0a82a},
+	{0x1f03fd18b711eea9, 0x566d89b1946d381a, 0x6e96e83fc92563ab, 0x405f66cf8cae1a32, 0xd7261740d8f18ce6, 0xfea3af64a413d0b2, 0xd64d1810e83520fe, 0xfec83621},
+	{0xf0316f286cf527b6, 0xf84c29538de1aa5a, 0x7612ed3c923d4a71, 0xd4eccebe9393ee8a, 0x2eb7867c2318cc59, 0x1ce621fd700fe396, 0x686450d7a346878a, 0x743d8dc},
+	{0x297008bcb3e3401d, 0x61a8e407f82b0c69, 0xa4a35bff0524fa0e, 0x7a61d8f552a53442, 0x821d1d8d8cfacf35, 0x7cc06361b86d0559, 0x119b617a8c2be199, 0x64d41d26},
+	{0x43c6252411ee3be, 0xb4ca1b8077777168, 0x2746dc3f7da1737f, 0x2247a4b2058d1c50, 0x1b3fa184b1d7bcc0, 0xdeb85613995c06ed, 0xcbe1d957485a3ccd, 0xacd90c81},
+	{0xce38a9a54fad6599, 0x6d6f4a90b9e8755e, 0xc3ecc79ff105de3f, 0xe8b9ee96efa2d0e, 0x90122905c4ab5358, 0x84f80c832d71979c, 0x229310f3ffbbf4c6, 0x7c746a4b},
+	{0x270a9305fef70cf, 0x600193999d884f3a, 0xf4d49eae09ed8a1, 0x2e091b85660f1298, 0xbfe37fae1cdd64c9, 0x8dddfbab930f6494, 0x2ccf4b08f5d417a, 0xb1047e99},
+	{0xe71be7c28e84d119, 0xeb6ace59932736e6, 0x70c4397807ba12c5, 0x7a9d77781ac53509, 0x4489c3ccfda3b39c, 0xfa722d4f243b4964, 0x25f15800bffdd122, 0xd1fd1068},
+	{0xb5b58c24b53aaa19, 0xd2a6ab0773dd897f, 0xef762fe01ecb5b97, 0x9deefbcfa4cab1f1, 0xb58f5943cd2492ba, 0xa96dcc4d1f4782a7, 0x102b62a82309dde5, 0x56486077},
+	{0x44dd59bd301995cf, 0x3ccabd76493ada1a, 0x540db4c87d55ef23, 0xcfc6d7adda35797, 0x14c7d1f32332cf03, 0x2d553ffbff3be99d, 0xc91c4ee0cb563182, 0x6069be80},
+	{0xb4d4789eb6f2630b, 0xbf6973263ce8ef0e, 0xd1c75c50844b9d3, 0xbce905900c1ec6ea, 0xc30f304f4045487d, 0xa5c550166b3a142b, 0x2f482b4e35327287, 0x2078359b},
+	{0x12807833c463737c, 0x58e927ea3b3776b4, 0x72dd20ef1c2f8ad0, 0x910b610de7a967bf, 0x801bc862120f6bf5, 0x9653efeed5897681, 0xf5367ff83e9ebbb3, 0x9ea21004},
+	{0xe88419922b87176f, 0xbcf32f41a7ddbf6f, 0xd6ebefd8085c1a0f, 0xd1d44fe99451ef72, 0xec951ba8e51e3545, 0xc0ca86b360746e96, 0xaa679cc066a8040b, 0x9c9cfe88},
+	{0x105191e0ec8f7f60, 0x5918dbfcca971e79, 0x6b285c8a944767b9, 0xd3e86ac4f5eccfa4, 0xe5399df2b106ca1, 0x814aadfacd217f1d, 0x2754e3def1c405a9, 0xb70a6ddd},
+	{0xa5b88bf7399a9f07, 0xfca3ddfd96461cc4, 0xebe738fdc0282fc6, 0x69afbc800606d0fb, 0x6104b97a9db12df7, 0xfcc09198bb90bf9f, 0xc5e077e41a65ba91, 0xdea37298},
+	{0xd08c3f5747d84f50, 0x4e708b27d1b6f8ac, 0x70f70fd734888606, 0x909ae019d761d019, 0x368bf4aab1b86ef9, 0x308bd616d5460239, 0x4fd33269f76783ea, 0x8f480819},
+	{0x2f72d12a40044b4b, 0x889689352fec53de, 0xf03e6ad87eb2f36, 0xef79f28d874b9e2d, 0xb512089e8e63b76c, 0x24dc06833bf193a9, 0x3c23308ba8e99d7e, 0x30b3b16},
+	{0xaa1f61fdc5c2e11e, 0xc2c56cd11277ab27, 0xa1e73069fdf1f94f, 0x8184bab36bb79df0, 0xc81929ce8655b940, 0x301b11bf8a4d8ce8, 0x73126fd45ab75de9, 0xf31bc4e8},
+	{0x9489b36fe2246244, 0x3355367033be74b8, 0x5f57c2277cbce516, 0xbc61414f9802ecaf, 0x8edd1e7a50562924, 0x48f4ab74a35e95f2, 0xcc1afcfd99a180e7, 0x419f953b},
+	{0x358d7c0476a044cd, 0xe0b7b47bcbd8854f, 0xffb42ec696705519, 0xd45e44c263e95c38, 0xdf61db53923ae3b1, 0xf2bc948cc4fc027c, 0x8a8000c6066772a3, 0x20e9e76d},
+	{0xb0c48df14275265a, 0x9da4448975905efa, 0xd716618e414ceb6d, 0x30e888af70df1e56, 0x4bee54bd47274f69, 0x178b4059e1a0afe5, 0x6e2c96b7f58e5178, 0x646f0ff8},
+	{0xdaa70bb300956588, 0x410ea6883a240c6d, 0xf5c8239fb5673eb3, 0x8b1d7bb4903c105f, 0xcfb1c322b73891d4, 0x5f3b792b22f07297, 0xfd64061f8be86811, 0xeeb7eca8},
+	{0x4ec97a20b6c4c7c2, 0x5913b1cd454f29fd, 0xa9629f9daf06d685, 0x852c9499156a8f3, 0x3a180a6abfb79016, 0x9fc3c4764037c3c9, 0x2890c42fc0d972cf, 0x8112bb9},
+	{0x5c3323628435a2e8, 0x1bea45ce9e72a6e3, 0x904f0a7027ddb52e, 0x939f31de14dcdc7b, 0xa68fdf4379df068, 0xf169e1f0b835279d, 0x7498e432f9619b27, 0x85a6d477},
+	{0xc1ef26bea260abdb, 0x6ee423f2137f9280, 0xdf2118b946ed0b43, 0x11b87fb1b900cc39, 0xe33e59b90dd815b1, 0xaa6cb5c4bafae741, 0x739699951ca8c713, 0x56f76c84},
+	{0x6be7381b115d653a, 0xed046190758ea511, 0xde6a45ffc3ed1159, 0xa64760e4041447d0, 0xe3eac49f3e0c5109, 0xdd86c4d4cb6258e2, 0xefa9857afd046c7f, 0x9af45d55},
+	{0xae3eece1711b2105, 0x14fd3f4027f81a4a, 0xabb7e45177d151db, 0x501f3e9b18861e44, 0x465201170074e7d8, 0x96d5c91970f2cb12, 0x40fd28c43506c95d, 0xd1c33760},
+	{0x376c28588b8fb389, 0x6b045e84d8491ed2, 0x4e857effb7d4e7dc, 0x154dd79fd2f984b4, 0xf11171775622c1c3, 0x1fbe30982e78e6f0, 0xa460a15dcf327e44, 0xc56bbf69},
+	{0x58d943503bb6748f, 0x419c6c8e88ac70f6, 0x586760cbf3d3d368, 0xb7e164979d5ccfc1, 0x12cb4230d26bf286, 0xf1bf910d44bd84cb, 0xb32c24c6a40272, 0xabecfb9b},
+	{0xdfff5989f5cfd9a1, 0xbcee2e7ea3a96f83, 0x681c7874adb29017, 0x3ff6c8ac7c36b63a, 0x48bc8831d849e326, 0x30b078e76b0214e2, 0x42954e6ad721b920, 0x8de13255},
+	{0x7fb19eb1a496e8f5, 0xd49e5dfdb5c0833f, 0xc0d5d7b2f7c48dc7, 0x1a57313a32f22dde, 0x30af46e49850bf8b, 0xaa0fe8d12f808f83, 0x443e31d70873bb6b, 0xa98ee299},
+	{0x5dba5b0dadccdbaa, 0x4ba8da8ded87fcdc, 0xf693fdd25badf2f0, 0xe9029e6364286587, 0xae69f49ecb46726c, 0x18e002679217c405, 0xbd6d66e85332ae9f, 0x3015f556},
+	{0x688bef4b135a6829, 0x8d31d82abcd54e8e, 0xf95f8a30d55036d7, 0x3d8c90e27aa2e147, 0x2ec937ce0aa236b4, 0x89b563996d3a0b78, 0x39b02413b23c3f08, 0x5a430e29},
+	{0xd8323be05433a412, 0x8d48fa2b2b76141d, 0x3d346f23978336a5, 0x4d50c7537562033f, 0x57dc7625b61dfe89, 0x9723a9f4c08ad93a, 0x5309596f48ab456b, 0x2797add0},
+	{0x3b5404278a55a7fc, 0x23ca0b327c2d0a81, 0xa6d65329571c892c, 0x45504801e0e6066b, 0x86e6c6d6152a3d04, 0x4f3db1c53eca2952, 0xd24d69b3e9ef10f3, 0x27d55016},
+	{0x2a96a3f96c5e9bbc, 0x8caf8566e212dda8, 0x904de559ca16e45e, 0xf13bc2d9c2fe222e, 0xbe4ccec9a6cdccfd, 0x37b2cbdd973a3ac9, 0x7b3223cd9c9497be, 0x84945a82},
+	{0x22bebfdcc26d18ff, 0x4b4d8dcb10807ba1, 0x40265eee30c6b896, 0x3752b423073b119a, 0x377dc5eb7c662bdb, 0x2b9f07f93a6c25b9, 0x96f24ede2bdc0718, 0x3ef7e224},
+	{0x627a2249ec6bbcc2, 0xc0578b462a46735a, 0x4974b8ee1c2d4f1f, 0xebdbb918eb6d837f, 0x8fb5f218dd84147c, 0xc77dd1f881df2c54, 0x62eac298ec226dc3, 0x35ed8dc8},
+	{0x3abaf1667ba2f3e0, 0xee78476b5eeadc1, 0x7e56ac0a6ca4f3f4, 0xf1b9b413df9d79ed, 0xa7621b6fd02db503, 0xd92f7ba9928a4ffe, 0x53f56babdcae96a6, 0x6a75e43d},
+	{0x3931ac68c5f1b2c9, 0xefe3892363ab0fb0, 0x40b707268337cd36, 0xa53a6b64b1ac85c9, 0xd50e7f86ee1b832b, 0x7bab08fdd26ba0a4, 0x7587743c18fe2475, 0x235d9805},
+	{0xb98fb0606f416754, 0x46a6e5547ba99c1e, 0xc909d82112a8ed2, 0xdbfaae9642b3205a, 0xf676a1339402bcb9, 0xf4f12a5b1ac11f29, 0x7db8bad81249dee4, 0xf7d69572},
+	{0x7f7729a33e58fcc4, 0x2e4bc1e7a023ead4, 0xe707008ea7ca6222, 0x47418a71800334a0, 0xd10395d8fc64d8a4, 0x8257a30062cb66f, 0x6786f9b2dc1ff18a, 0xbacd0199},
+	{0x42a0aa9ce82848b3, 0x57232730e6bee175, 0xf89bb3f370782031, 0xcaa33cf9b4f6619c, 0xb2c8648ad49c209f, 0x9e89ece0712db1c0, 0x101d8274a711a54b, 0xe428f50e},
+	{0x6b2c6d38408a4889, 0xde3ef6f68fb25885, 0x20754f456c203361, 0x941f5023c0c943f9, 0xdfdeb9564fd66f24, 0x2140cec706b9d406, 0x7b22429b131e9c72, 0x81eaaad3},
+	{0x930380a3741e862a, 0x348d28638dc71658, 0x89dedcfd1654ea0d, 0x7e7f61684080106, 0x837ace9794582976, 0x5ac8ca76a357eb1b, 0x32b58308625661fb, 0xaddbd3e3},
+	{0x94808b5d2aa25f9a, 0xcec72968128195e0, 0xd9f4da2bdc1e130f, 0x272d8dd74f3006cc, 0xec6c2ad1ec03f554, 0x4ad276b249a5d5dd, 0x549a22a17c0cde12, 0xe66dbca0},
+	{0xb31abb08ae6e3d38, 0x9eb9a95cbd9e8223, 0x8019e79b7ee94ea9, 0x7b2271a7a3248e22, 0x3b4f700e5a0ba523, 0x8ebc520c227206fe, 0xda3f861490f5d291, 0xafe11fd5},
+	{0xdccb5534a893ea1a, 0xce71c398708c6131, 0xfe2396315457c164, 0x3f1229f4d0fd96fb, 0x33130aa5fa9d43f2, 0xe42693d5b34e63ab, 0x2f4ef2be67f62104, 0xa71a406f},
+	{0x6369163565814de6, 0x8feb86fb38d08c2f, 0x4976933485cc9a20, 0x7d3e82d5ba29a90d, 0xd5983cc93a9d126a, 0x37e9dfd950e7b692, 0x80673be6a7888b87, 0x9d90eaf5},
+	{0xedee4ff253d9f9b3, 0x96ef76fb279ef0ad, 0xa4d204d179db2460, 0x1f3dcdfa513512d6, 0x4dc7ec07283117e4, 0x4438bae88ae28bf9, 0xaa7eae72c9244a0d, 0x6665db10},
+	{0x941993df6e633214, 0x929bc1beca5b72c6, 0x141fc52b8d55572d, 0xb3b782ad308f21ed, 0x4f2676485041dee0, 0xbfe279aed5cb4bc8, 0x2a62508a467a22ff, 0x9c977cbf},
+	{0x859838293f64cd4c, 0x484403b39d44ad79, 0xbf674e64d64b9339, 0x44d68afda9568f08, 0x478568ed51ca1d65, 0x679c204ad3d9e766, 0xb28e788878488dc1, 0xee83ddd4},
+	{0xc19b5648e0d9f555, 0x328e47b2b7562993, 0xe756b92ba4bd6a51, 0xc3314e362764ddb8, 0x6481c084ee9ec6b5, 0xede23fb9a251771, 0xbd617f2643324590, 0x26519cc},
+	{0xf963b63b9006c248, 0x9e9bf727ffaa00bc, 0xc73bacc75b917e3a, 0x2c6aa706129cc54c, 0x17a706f59a49f086, 0xc7c1eec455217145, 0x6adfdc6e07602d42, 0xa485a53f},
+	{0x6a8aa0852a8c1f3b, 0xc8f1e5e206a21016, 0x2aa554aed1ebb524, 0xfc3e3c322cd5d89b, 0xb7e3911dc2bd4ebb, 0xfcd6da5e5fae833a, 0x51ed3c41f87f9118, 0xf62bc412},
+	{0x740428b4d45e5fb8, 0x4c95a4ce922cb0a5, 0xe99c3ba78feae796, 0x914f1ea2fdcebf5c, 0x9566453c07cd0601, 0x9841bf66d0462cd, 0x79140c1c18536aeb, 0x8975a436},
+	{0x658b883b3a872b86, 0x2f0e303f0f64827a, 0x975337e23dc45e1, 0x99468a917986162b, 0x7b31434aac6e0af0, 0xf6915c1562c7d82f, 0xe4071d82a6dd71db, 0x94ff7f41},
+	{0x6df0a977da5d27d4, 0x891dd0e7cb19508, 0xfd65434a0b71e680, 0x8799e4740e573c50, 0x9e739b52d0f341e8, 0xcdfd34ba7d7b03eb, 0x5061812ce6c88499, 0x760aa031},
+	{0xa900275464ae07ef, 0x11f2cfda34beb4a3, 0x9abf91e5a1c38e4, 0x8063d80ab26f3d6d, 0x4177b4b9b4f0393f, 0x6de42ba8672b9640, 0xd0bccdb72c51c18, 0x3bda76df},
+	{0x810bc8aa0c40bcb0, 0x448a019568d01441, 0xf60ec52f60d3aeae, 0x52c44837aa6dfc77, 0x15d8d8fccdd6dc5b, 0x345b793ccfa93055, 0x932160fe802ca975, 0x498e2e65},
+	{0x22036327deb59ed7, 0xadc05ceb97026a02, 0x48bff0654262672b, 0xc791b313aba3f258, 0x443c7757a4727bee, 0xe30e4b2372171bdf, 0xf3db986c4156f3cb, 0xd38deb48},
+	{0x7d14dfa9772b00c8, 0x595735efc7eeaed7, 0x29872854f94c3507, 0xbc241579d8348401, 0x16dc832804d728f0, 0xe9cc71ae64e3f09e, 0xbef634bc978bac31, 0x82b3fb6b},
+	{0x2d777cddb912675d, 0x278d7b10722a13f9, 0xf5c02bfb7cc078af, 0x4283001239888836, 0xf44ca39a6f79db89, 0xed186122d71bcc9f, 0x8620017ab5f3ba3b, 0xe500e25f},
+	{0xf2ec98824e8aa613, 0x5eb7e3fb53fe3bed, 0x12c22860466e1dd4, 0x374dd4288e0b72e5, 0xff8916db706c0df4, 0xcb1a9e85de5e4b8d, 0xd4d12afb67a27659, 0xbd2bb07c},
+	{0x5e763988e21f487f, 0x24189de8065d8dc5, 0xd1519d2403b62aa0, 0x9136456740119815, 0x4d8ff7733b27eb83, 0xea3040bc0c717ef8, 0x7617ab400dfadbc, 0x3a2b431d},
+	{0x48949dc327bb96ad, 0xe1fd21636c5c50b4, 0x3f6eb7f13a8712b4, 0x14cf7f02dab0eee8, 0x6d01750605e89445, 0x4f1cf4006e613b78, 0x57c40c4db32bec3b, 0x7322a83d},
+	{0xb7c4209fb24a85c5, 0xb35feb319c79ce10, 0xf0d3de191833b922, 0x570d62758ddf6397, 0x5e0204fb68a7b800, 0x4383a9236f8b5a2b, 0x7bc1a64641d803a4, 0xa645ca1c},
+	{0x9c9e5be0943d4b05, 0xb73dc69e45201cbb, 0xaab17180bfe5083d, 0xc738a77a9a55f0e2, 0x705221addedd81df, 0xfd9bd8d397abcfa3, 0x8ccf0004aa86b795, 0x8909a45a},
+	{0x3898bca4dfd6638d, 0xf911ff35efef0167, 0x24bdf69e5091fc88, 0x9b82567ab6560796, 0x891b69462b41c224, 0x8eccc7e4f3af3b51, 0x381e54c3c8f1c7d0, 0xbd30074c},
+	{0x5b5d2557400e68e7, 0x98d610033574cee, 0xdfd08772ce385deb, 0x3c13e894365dc6c2, 0x26fc7bbcda3f0ef, 0xdbb71106cdbfea36, 0x785239a742c6d26d, 0xc17cf001},
+	{0xa927ed8b2bf09bb6, 0x606e52f10ae94eca, 0x71c2203feb35a9ee, 0x6e65ec14a8fb565, 0x34bff6f2ee5a7f79, 0x2e329a5be2c011b, 0x73161c93331b14f9, 0x26ffd25a},
+	{0x8d25746414aedf28, 0x34b1629d28b33d3a, 0x4d5394aea5f82d7b, 0x379f76458a3c8957, 0x79dd080f9843af77, 0xc46f0a7847f60c1d, 0xaf1579c5797703cc, 0xf1d8ce3c},
+	{0xb5bbdb73458712f2, 0x1ff887b3c2a35137, 0x7f7231f702d0ace9, 0x1e6f0910c3d25bd8, 0xad9e250862102467, 0x1c842a07abab30cd, 0xcd8124176bac01ac, 0x3ee8fb17},
+	{0x3d32a26e3ab9d254, 0xfc4070574dc30d3a, 0xf02629579c2b27c9, 0xb1cf09b0184a4834, 0x5c03db48eb6cc159, 0xf18c7fcf34d1df47, 0xdfb043419ecf1fa9, 0xa77acc2a},
+	{0x9371d3c35fa5e9a5, 0x42967cf4d01f30, 0x652d1eeae704145c, 0xceaf1a0d15234f15, 0x1450a54e45ba9b9, 0x65e9c1fd885aa932, 0x354d4bc034ba8cbe, 0xf4556dee},
+	{0xcbaa3cb8f64f54e0, 0x76c3b48ee5c08417, 0x9f7d24e87e61ce9, 0x85b8e53f22e19507, 0xbb57137739ca486b, 0xc77f131cca38f761, 0xc56ac3cf275be121, 0xde287a64},
+	{0xb2e23e8116c2ba9f, 0x7e4d9c0060101151, 0x3310da5e5028f367, 0xadc52dddb76f6e5e, 0x4aad4e925a962b68, 0x204b79b7f7168e64, 0xdf29ed6671c36952, 0x878e55b9},
+	{0x8aa77f52d7868eb9, 0x4d55bd587584e6e2, 0xd2db37041f495f5, 0xce030d15b5fe2f4, 0x86b4a7a0780c2431, 0xee070a9ae5b51db7, 0xedc293d9595be5d8, 0x7648486},
+	{0x858fea922c7fe0c3, 0xcfe8326bf733bc6f, 0x4e5e2018cf8f7dfc, 0x64fd1bc011e5bab7, 0x5c9e858728015568, 0x97ac42c2b00b29b1, 0x7f89caf08c109aee, 0x57ac0fb1},
+	{0x46ef25fdec8392b1, 0xe48d7b6d42a5cd35, 0x56a6fe1c175299ca, 0xfdfa836b41dcef62, 0x2f8db8030e847e1b, 0x5ba0a49ac4f9b0f8, 0xdae897ed3e3fce44, 0xd01967ca},
+	{0x8d078f726b2df464, 0xb50ee71cdcabb299, 0xf4af300106f9c7ba, 0x7d222caae025158a, 0xcc028d5fd40241b9, 0xdd42515b639e6f97, 0xe08e86531a58f87f, 0x96ecdf74},
+	{0x35ea86e6960ca950, 0x34fe1fe234fc5c76, 0xa00207a3dc2a72b7, 0x80395e48739e1a67, 0x74a67d8f7f43c3d7, 0xdd2bdd1d62246c6e, 0xa1f44298ba80acf6, 0x779f5506},
+	{0x8aee9edbc15dd011, 0x51f5839dc8462695, 0xb2213e17c37dca2d, 0x133b299a939745c5, 0x796e2aac053f52b3, 0xe8d9fe1521a4a222, 0x819a8863e5d1c290, 0x3c94c2de},
+	{0xc3e142ba98432dda, 0x911d060cab126188, 0xb753fbfa8365b844, 0xfd1a9ba5e71b08a2, 0x7ac0dc2ed7778533, 0xb543161ff177188a, 0x492fc08a6186f3f4, 0x39f98faf},
+	{0x123ba6b99c8cd8db, 0x448e582672ee07c4, 0xcebe379292db9e65, 0x938f5bbab544d3d6, 0xd2a95f9f2d376d73, 0x68b2f16149e81aa3, 0xad7e32f82d86c79d, 0x7af31199},
+	{0xba87acef79d14f53, 0xb3e0fcae63a11558, 0xd5ac313a593a9f45, 0xeea5f5a9f74af591, 0x578710bcc36fbea2, 0x7a8393432188931d, 0x705cfc5ec7cc172, 0xe341a9d6},
+	{0xbcd3957d5717dc3, 0x2da746741b03a007, 0x873816f4b1ece472, 0x2b826f1a2c08c289, 0xda50f56863b55e74, 0xb18712f6b3eed83b, 0xbdc7cc05ab4c685f, 0xca24aeeb},
+	{0x61442ff55609168e, 0x6447c5fc76e8c9cf, 0x6a846de83ae15728, 0xeffc2663cffc777f, 0x93214f8f463afbed, 0xa156ef06066f4e4e, 0xa407b6ed8769d51e, 0xb2252b57},
+	{0xdbe4b1b2d174757f, 0x506512da18712656, 0x6857f3e0b8dd95f, 0x5a4fc2728a9bb671, 0xebb971522ec38759, 0x1a5a093e6cf1f72b, 0x729b057fe784f504, 0x72c81da1},
+	{0x531e8e77b363161c, 0xeece0b43e2dae030, 0x8294b82c78f34ed1, 0xe777b1fd580582f2, 0x7b880f58da112699, 0x562c6b189a6333f4, 0x139d64f88a611d4, 0x6b9fce95},
+	{0xf71e9c926d711e2b, 0xd77af2853a4ceaa1, 0x9aa0d6d76a36fae7, 0xdd16cd0fbc08393, 0x29a414a5d8c58962, 0x72793d8d1022b5b2, 0x2e8e69cf7cbffdf0, 0x19399857},
+	{0xcb20ac28f52df368, 0xe6705ee7880996de, 0x9b665cc3ec6972f2, 0x4260e8c254e9924b, 0xf197a6eb4591572d, 0x8e867ff0fb7ab27c, 0xf95502fb503efaf3, 0x3c57a994},
+	{0xe4a794b4acb94b55, 0x89795358057b661b, 0x9c4cdcec176d7a70, 0x4890a83ee435bc8b, 0xd8c1c00fceb00914, 0x9e7111ba234f900f, 0xeb8dbab364d8b604, 0xc053e729},
+	{0xcb942e91443e7208, 0xe335de8125567c2a, 0xd4d74d268b86df1f, 0x8ba0fdd2ffc8b239, 0xf413b366c1ffe02f, 0xc05b2717c59a8a28, 0x981188eab4fcc8fb, 0x51cbbba7},
+	{0xecca7563c203f7ba, 0x177ae2423ef34bb2, 0xf60b7243400c5731, 0xcf1edbfe7330e94e, 0x881945906bcb3cc6, 0x4acf0293244855da, 0x65ae042c1c2a28c2, 0x1acde79a},
+	{0x1652cb940177c8b5, 0x8c4fe7d85d2a6d6d, 0xf6216ad097e54e72, 0xf6521b912b368ae6, 0xa9fe4eff81d03e73, 0xd6f623629f80d1a3, 0x2b9604f32cb7dc34, 0x2d160d13},
+	{0x31fed0fc04c13ce8, 0x3d5d03dbf7ff240a, 0x727c5c9b51581203, 0x6b5ffc1f54fecb29, 0xa8e8e7ad5b9a21d9, 0xc4d5a32cd6aac22d, 0xd7e274ad22d4a79a, 0x787f5801},
+	{0xe7b668947590b9b3, 0xbaa41ad32938d3fa, 0xabcbc8d4ca4b39e4, 0x381ee1b7ea534f4e, 0xda3759828e3de429, 0x3e015d76729f9955, 0xcbbec51a6485fbde, 0xc9629828},
+	{0x1de2119923e8ef3c, 0x6ab27c096cf2fe14, 0x8c3658edca958891, 0x4cc8ed3ada5f0f2, 0x4a496b77c1f1c04e, 0x9085b0a862084201, 0xa1894bde9e3dee21, 0xbe139231},
+	{0x1269df1e69e14fa7, 0x992f9d58ac5041b7, 0xe97fcf695a7cbbb4, 0xe5d0549802d15008, 0x424c134ecd0db834, 0x6fc44fd91be15c6c, 0xa1a5ef95d50e537d, 0x7df699ef},
+	{0x820826d7aba567ff, 0x1f73d28e036a52f3, 0x41c4c5a73f3b0893, 0xaa0d74d4a98db89b, 0x36fd486d07c56e1d, 0xd0ad23cbb6660d8a, 0x1264a84665b35e19, 0x8ce6b96d},
+	{0xffe0547e4923cef9, 0x3534ed49b9da5b02, 0x548a273700fba03d, 0x28ac84ca70958f7e, 0xd8ae575a68faa731, 0x2aaaee9b9dcffd4c, 0x6c7faab5c285c6da, 0x6f9ed99c},
+	{0x72da8d1b11d8bc8b, 0xba94b56b91b681c6, 0x4e8cc51bd9b0fc8c, 0x43505ed133be672a, 0xe8f2f9d973c2774e, 0x677b9b9c7cad6d97, 0x4e1f5d56ef17b906, 0xe0244796},
+	{0xd62ab4e3f88fc797, 0xea86c7aeb6283ae4, 0xb5b93e09a7fe465, 0x4344a1a0134afe2, 0xff5c17f02b62341d, 0x3214c6a587ce4644, 0xa905e7ed0629d05c, 0x4ccf7e75},
+	{0xd0f06c28c7b36823, 0x1008cb0874de4bb8, 0xd6c7ff816c7a737b, 0x489b697fe30aa65f, 0x4da0fb621fdc7817, 0xdc43583b82c58107, 0x4b0261debdec3cd6, 0x915cef86},
+	{0x99b7042460d72ec6, 0x2a53e5e2b8e795c2, 0x53a78132d9e1b3e3, 0xc043e67e6fc64118, 0xff0abfe926d844d3, 0xf2a9fe5db2e910fe, 0xce352cdc84a964dd, 0x5cb59482},
+	{0x4f4dfcfc0ec2bae5, 0x841233148268a1b8, 0x9248a76ab8be0d3, 0x334c5a25b5903a8c, 0x4c94fef443122128, 0x743e7d8454655c40, 0x1ab1e6d1452ae2cd, 0x6ca3f532},
+	{0xfe86bf9d4422b9ae, 0xebce89c90641ef9c, 0x1c84e2292c0b5659, 0x8bde625a10a8c50d, 0xeb8271ded1f79a0b, 0x14dc6844f0de7a3c, 0xf85b2f9541e7e6da, 0xe24f3859},
+	{0xa90d81060932dbb0, 0x8acfaa88c5fbe92b, 0x7c6f3447e90f7f3f, 0xdd52fc14c8dd3143, 0x1bc7508516e40628, 0x3059730266ade626, 0xffa526822f391c2, 0xadf5a9c7},
+	{0x17938a1b0e7f5952, 0x22cadd2f56f8a4be, 0x84b0d1183d5ed7c1, 0xc1336b92fef91bf6, 0x80332a3945f33fa9, 0xa0f68b86f726ff92, 0xa3db5282cf5f4c0b, 0x32264b75},
+	{0xde9e0cb0e16f6e6d, 0x238e6283aa4f6594, 0x4fb9c914c2f0a13b, 0x497cb912b670f3b, 0xd963a3f02ff4a5b6, 0x4fccefae11b50391, 0x42ba47db3f7672f, 0xa64b3376},
+	{0x6d4b876d9b146d1a, 0xaab2d64ce8f26739, 0xd315f93600e83fe5, 0x2fe9fabdbe7fdd4, 0x755db249a2d81a69, 0xf27929f360446d71, 0x79a1bf957c0c1b92, 0xd33890e},
+	{0xe698fa3f54e6ea22, 0xbd28e20e7455358c, 0x9ace161f6ea76e66, 0xd53fb7e3c93a9e4, 0x737ae71b051bf108, 0x7ac71feb84c2df42, 0x3d8075cd293a15b4, 0x926d4b63},
+	{0x7bc0deed4fb349f7, 0x1771aff25dc722fa, 0x19ff0644d9681917, 0xcf7d7f25bd70cd2c, 0x9464ed9baeb41b4f, 0xb9064f5c3cb11b71, 0x237e39229b012b20, 0xd51ba539},
+	{0xdb4b15e88533f622, 0x256d6d2419b41ce9, 0x9d7c5378396765d5, 0x9040e5b936b8661b, 0x276e08fa53ac27fd, 0x8c944d39c2bdd2cc, 0xe2514c9802a5743c, 0x7f37636d},
+	{0x922834735e86ecb2, 0x363382685b88328e, 0xe9c92960d7144630, 0x8431b1bfd0a2379c, 0x90383913aea283f9, 0xa6163831eb4924d2, 0x5f3921b4f9084aee, 0xb98026c0},
+	{0x30f1d72c812f1eb8, 0xb567cd4a69cd8989, 0x820b6c992a51f0bc, 0xc54677a80367125e, 0x3204fbdba462e606, 0x8563278afc9eae69, 0x262147dd4bf7e566, 0xb877767e},
+	{0x168884267f3817e9, 0x5b376e050f637645, 0x1c18314abd34497a, 0x9598f6ab0683fcc2, 0x1c805abf7b80e1ee, 0xdec9ac42ee0d0f32, 0x8cd72e3912d24663, 0xaefae77},
+	{0x82e78596ee3e56a7, 0x25697d9c87f30d98, 0x7600a8342834924d, 0x6ba372f4b7ab268b, 0x8c3237cf1fe243df, 0x3833fc51012903df, 0x8e31310108c5683f, 0xf686911},
+	{0xaa2d6cf22e3cc252, 0x9b4dec4f5e179f16, 0x76fb0fba1d99a99a, 0x9a62af3dbba140da, 0x27857ea044e9dfc1, 0x33abce9da2272647, 0xb22a7993aaf32556, 0x3deadf12},
+	{0x7bf5ffd7f69385c7, 0xfc077b1d8bc82879, 0x9c04e36f9ed83a24, 0x82065c62e6582188, 0x8ef787fd356f5e43, 0x2922e53e36e17dfa, 0x9805f223d385010b, 0xccf02a4e},
+	{0xe89c8ff9f9c6e34b, 0xf54c0f669a49f6c4, 0xfc3e46f5d846adef, 0x22f2aa3df2221cc, 0xf66fea90f5d62174, 0xb75defaeaa1dd2a7, 0x9b994cd9a7214fd5, 0x176c1722},
+	{0xa18fbcdccd11e1f4, 0x8248216751dfd65e, 0x40c089f208d89d7c, 0x229b79ab69ae97d, 0xa87aabc2ec26e582, 0xbe2b053721eb26d2, 0x10febd7f0c3d6fcb, 0x26f82ad},
+	{0x2d54f40cc4088b17, 0x59d15633b0cd1399, 0xa8cc04bb1bffd15b, 0xd332cdb073d8dc46, 0x272c56466868cb46, 0x7e7fcbe35ca6c3f3, 0xee8f51e5a70399d4, 0xb5244f42},
+	{0x69276946cb4e87c7, 0x62bdbe6183be6fa9, 0x3ba9773dac442a1a, 0x702e2afc7f5a1825, 0x8c49b11ea8151fdc, 0xcaf3fef61f5a86fa, 0xef0b2ee8649d7272, 0x49a689e5},
+	{0x668174a3f443df1d, 0x407299392da1ce86, 0xc2a3f7d7f2c5be28, 0xa590b202a7a5807b, 0x968d2593f7ccb54e, 0x9dd8d669e3e95dec, 0xee0cc5dd58b6e93a, 0x59fcdd3},
+	{0x5e29be847bd5046, 0xb561c7f19c8f80c3, 0x5e5abd5021ccaeaf, 0x7432d63888e0c306, 0x74bbceeed479cb71, 0x6471586599575fdf, 0x6a859ad23365cba2, 0x4f4b04e9},
+	{0xcd0d79f2164da014, 0x4c386bb5c5d6ca0c, 0x8e771b03647c3b63, 0x69db23875cb0b715, 0xada8dd91504ae37f, 0x46bf18dbf045ed6a, 0xe1b5f67b0645ab63, 0x8b00f891},
+	{0xe0e6fc0b1628af1d, 0x29be5fb4c27a2949, 0x1c3f781a604d3630, 0xc4af7faf883033aa, 0x9bd296c4e9453cac, 0xca45426c1f7e33f9, 0xa6bbdcf7074d40c5, 0x16e114f3},
+	{0x2058927664adfd93, 0x6e8f968c7963baa5, 0xaf3dced6fff7c394, 0x42e34cf3d53c7876, 0x9cddbb26424dc5e, 0x64f6340a6d8eddad, 0x2196e488eb2a3a4b, 0xd6b6dadc},
+	{0xdc107285fd8e1af7, 0xa8641a0609321f3f, 0xdb06e89ffdc54466, 0xbcc7a81ed5432429, 0xb6d7bdc6ad2e81f1, 0x93605ec471aa37db, 0xa2a73f8a85a8e397, 0x897e20ac},
+	{0xfbba1afe2e3280f1, 0x755a5f392f07fce, 0x9e44a9a15402809a, 0x6226a32e25099848, 0xea895661ecf53004, 0x4d7e0158db2228b9, 0xe5a7d82922f69842, 0xf996e05d},
+	{0xbfa10785ddc1011b, 0xb6e1c4d2f670f7de, 0x517d95604e4fcc1f, 0xca6552a0dfb82c73, 0xb024cdf09e34ba07, 0x66cd8c5a95d7393b, 0xe3939acf790d4a74, 0xc4306af6},
+	{0x534cc35f0ee1eb4e, 0xb703820f1f3b3dce, 0x884aa164cf22363, 0xf14ef7f47d8a57a3, 0x80d1f86f2e061d7c, 0x401d6c2f151b5a62, 0xe988460224108944, 0x6dcad433},
+	{0x7ca6e3933995dac, 0xfd118c77daa8188, 0x3aceb7b5e7da6545, 0xc8389799445480db, 0x5389f5df8aacd50d, 0xd136581f22fab5f, 0xc2f31f85991da417, 0x3c07374d},
+	{0xf0d6044f6efd7598, 0xe044d6ba4369856e, 0x91968e4f8c8a1a4c, 0x70bd1968996bffc2, 0x4c613de5d8ab32ac, 0xfe1f4f97206f79d8, 0xac0434f2c4e213a9, 0xf0f4602c},
+	{0x3d69e52049879d61, 0x76610636ea9f74fe, 0xe9bf5602f89310c0, 0x8eeb177a86053c11, 0xe390122c345f34a2, 0x1e30e47afbaaf8d6, 0x7b892f68e5f91732, 0x3e1ea071},
+	{0x79da242a16acae31, 0x183c5f438e29d40, 0x6d351710ae92f3de, 0x27233b28b5b11e9b, 0xc7dfe8988a942700, 0x570ed11c4abad984, 0x4b4c04632f48311a, 0x67580f0c},
+	{0x461c82656a74fb57, 0xd84b491b275aa0f7, 0x8f262cb29a6eb8b2, 0x49fa3070bc7b06d0, 0xf12ed446bd0c0539, 0x6d43ac5d1dd4b240, 0x7609524fe90bec93, 0x4e109454},
+	{0x53c1a66d0b13003, 0x731f060e6fe797fc, 0xdaa56811791371e3, 0x57466046cf6896ed, 0x8ac37e0e8b25b0c6, 0x3e6074b52ad3cf18, 0xaa491ce7b45db297, 0x88a474a7},
+	{0xd3a2efec0f047e9, 0x1cabce58853e58ea, 0x7a17b2eae3256be4, 0xc2dcc9758c910171, 0xcb5cddaeff4ddb40, 0x5d7cc5869baefef1, 0x9644c5853af9cfeb, 0x5b5bedd},
+	{0x43c64d7484f7f9b2, 0x5da002b64aafaeb7, 0xb576c1e45800a716, 0x3ee84d3d5b4ca00b, 0x5cbc6d701894c3f9, 0xd9e946f5ae1ca95, 0x24ca06e67f0b1833, 0x1aaddfa7},
+	{0xa7dec6ad81cf7fa1, 0x180c1ab708683063, 0x95e0fd7008d67cff, 0x6b11c5073687208, 0x7e0a57de0d453f3, 0xe48c267d4f646867, 0x2168e9136375f9cb, 0x5be07fd8},
+	{0x5408a1df99d4aff, 0xb9565e588740f6bd, 0xabf241813b08006e, 0x7da9e81d89fda7ad, 0x274157cabe71440d, 0x2c22d9a480b331f7, 0xe835c8ac746472d5, 0xcbca8606},
+	{0xa8b27a6bcaeeed4b, 0xaec1eeded6a87e39, 0x9daf246d6fed8326, 0xd45a938b79f54e8f, 0x366b219d6d133e48, 0x5b14be3c25c49405, 0xfdd791d48811a572, 0xbde64d01},
+	{0x9a952a8246fdc269, 0xd0dcfcac74ef278c, 0x250f7139836f0f1f, 0xc83d3c5f4e5f0320, 0x694e7adeb2bf32e5, 0x7ad09538a3da27f5, 0x2b5c18f934aa5303, 0xee90cf33},
+	{0xc930841d1d88684f, 0x5eb66eb18b7f9672, 0xe455d413008a2546, 0xbc271bc0df14d647, 0xb071100a9ff2edbb, 0x2b1a4c1cc31a119a, 0xb5d7caa1bd946cef, 0x4305c3ce},
+	{0x94dc6971e3cf071a, 0x994c7003b73b2b34, 0xea16e85978694e5, 0x336c1b59a1fc19f6, 0xc173acaecc471305, 0xdb1267d24f3f3f36, 0xe9a5ee98627a6e78, 0x4b3a1d76},
+	{0x7fc98006e25cac9, 0x77fee0484cda86a7, 0x376ec3d447060456, 0x84064a6dcf916340, 0xfbf55a26790e0ebb, 0x2e7f84151c31a5c2, 0x9f7f6d76b950f9bf, 0xa8bb6d80},
+	{0xbd781c4454103f6, 0x612197322f49c931, 0xb9cf17fd7e5462d5, 0xe38e526cd3324364, 0x85f2b63a5b5e840a, 0x485d7cef5aaadd87, 0xd2b837a462f6db6d, 0x1f9fa607},
+	{0xda60e6b14479f9df, 0x3bdccf69ece16792, 0x18ebf45c4fecfdc9, 0x16818ee9d38c6664, 0x5519fa9a1e35a329, 0xcbd0001e4b08ed8, 0x41a965e37a0c731b, 0x8d0e4ed2},
+	{0x4ca56a348b6c4d3, 0x60618537c3872514, 0x2fbb9f0e65871b09, 0x30278016830ddd43, 0xf046646d9012e074, 0xc62a5804f6e7c9da, 0x98d51f5830e2bc1e, 0x1bf31347},
+	{0xebd22d4b70946401, 0x6863602bf7139017, 0xc0b1ac4e11b00666, 0x7d2782b82bd494b6, 0x97159ba1c26b304b, 0x42b3b0fd431b2ac2, 0xfaa81f82691c830c, 0x1ae3fc5b},
+	{0x3cc4693d6cbcb0c, 0x501689ea1c70ffa, 0x10a4353e9c89e364, 0x58c8aba7475e2d95, 0x3e2f291698c9427a, 0xe8710d19c9de9e41, 0x65dda22eb04cf953, 0x459c3930},
+	{0x38908e43f7ba5ef0, 0x1ab035d4e7781e76, 0x41d133e8c0a68ff7, 0xd1090893afaab8bc, 0x96c4fe6922772807, 0x4522426c2b4205eb, 0xefad99a1262e7e0d, 0xe00c4184},
+	{0x34983ccc6aa40205, 0x21802cad34e72bc4, 0x1943e8fb3c17bb8, 0xfc947167f69c0da5, 0xae79cfdb91b6f6c1, 0x7b251d04c26cbda3, 0x128a33a79060d25e, 0xffc7a781},
+	{0x86215c45dcac9905, 0xea546afe851cae4b, 0xd85b6457e489e374, 0xb7609c8e70386d66, 0x36e6ccc278d1636d, 0x2f873307c08e6a1c, 0x10f252a758505289, 0x6a125480},
+	{0x420fc255c38db175, 0xd503cd0f3c1208d1, 0xd4684e74c825a0bc, 0x4c10537443152f3d, 0x720451d3c895e25d, 0xaff60c4d11f513fd, 0x881e8d6d2d5fb953, 0x88a1512b},
+	{0x1d7a31f5bc8fe2f9, 0x4763991092dcf836, 0xed695f55b97416f4, 0xf265edb0c1c411d7, 0x30e1e9ec5262b7e6, 0xc2c3ba061ce7957a, 0xd975f93b89a16409, 0x549bbbe5},
+	{0x94129a84c376a26e, 0xc245e859dc231933, 0x1b8f74fecf917453, 0xe9369d2e9007e74b, 0xb1375915d1136052, 0x926c2021fe1d2351, 0x1d943addaaa2e7e6, 0xc133d38c},
+	{0x1d3a9809dab05c8d, 0xadddeb4f71c93e8, 0xef342eb36631edb, 0x301d7a61c4b3dbca, 0x861336c3f0552d61, 0x12c6db947471300f, 0xa679ef0ed761deb9, 0xfcace348},
+	{0x90fa3ccbd60848da, 0xdfa6e0595b569e11, 0xe585d067a1f5135d, 0x6cef866ec295abea, 0xc486c0d9214beb2d, 0xd6e490944d5fe100, 0x59df3175d72c9f38, 0xed7b6f9a},
+	{0x2dbb4fc71b554514, 0x9650e04b86be0f82, 0x60f2304fba9274d3, 0xfcfb9443e997cab, 0xf13310d96dec2772, 0x709cad2045251af2, 0xafd0d30cc6376dad, 0x6d907dda},
+	{0xb98bf4274d18374a, 0x1b669fd4c7f9a19a, 0xb1f5972b88ba2b7a, 0x73119c99e6d508be, 0x5d4036a187735385, 0x8fa66e192fd83831, 0x2abf64b6b592ed57, 0x7a4d48d5},
+	{0xd6781d0b5e18eb68, 0xb992913cae09b533, 0x58f6021caaee3a40, 0xaafcb77497b5a20b, 0x411819e5e79b77a3, 0xbd779579c51c77ce, 0x58d11f5dcf5d075d, 0xe686f3db},
+	{0x226651cf18f4884c, 0x595052a874f0f51c, 0xc9b75162b23bab42, 0x3f44f873be4812ec, 0x427662c1dbfaa7b2, 0xa207ff9638fb6558, 0xa738d919e45f550f, 0xcce7c55},
+	{0xa734fb047d3162d6, 0xe523170d240ba3a5, 0x125a6972809730e8, 0xd396a297799c24a1, 0x8fee992e3069bad5, 0x2e3a01b0697ccf57, 0xee9c7390bd901cfa, 0xf58b96b},
+	{0xc6df6364a24f75a3, 0xc294e2c84c4f5df8, 0xa88df65c6a89313b, 0x895fe8443183da74, 0xc7f2f6f895a67334, 0xa0d6b6a506691d31, 0x24f51712b459a9f0, 0x1bbf6f60},
+	{0xd8d1364c1fbcd10, 0x2d7cc7f54832deaa, 0x4e22c876a7c57625, 0xa3d5d1137d30c4bd, 0x1e7d706a49bdfb9e, 0xc63282b20ad86db2, 0xaec97fa07916bfd6, 0xce5e0cc2},
+	{0xaae06f9146db885f, 0x3598736441e280d9, 0xfba339b117083e55, 0xb22bf08d9f8aecf7, 0xc182730de337b922, 0x2b9adc87a0450a46, 0x192c29a9cfc00aad, 0x584cfd6f},
+	{0x8955ef07631e3bcc, 0x7d70965ea3926f83, 0x39aed4134f8b2db6, 0x882efc2561715a9c, 0xef8132a18a540221, 0xb20a3c87a8c257c1, 0xf541b8628fad6c23, 0x8f9bbc33},
+	{0xad611c609cfbe412, 0xd3c00b18bf253877, 0x90b2172e1f3d0bfd, 0x371a98b2cb084883, 0x33a2886ee9f00663, 0xbe9568818ed6e6bd, 0xf244a0fa2673469a, 0xd7640d95},
+	{0xd5339adc295d5d69, 0xb633cc1dcb8b586a, 0xee84184cf5b1aeaf, 0x89f3aab99afbd636, 0xf420e004f8148b9a, 0x6818073faa797c7c, 0xdd3b4e21cbbf42ca, 0x3d12a2b},
+	{0x40d0aeff521375a8, 0x77ba1ad7ecebd506, 0x547c6f1a7d9df427, 0x21c2be098327f49b, 0x7e035065ac7bbef5, 0x6d7348e63023fb35, 0x9d427dc1b67c3830, 0xaaeafed0},
+	{0x8b2d54ae1a3df769, 0x11e7adaee3216679, 0x3483781efc563e03, 0x9d097dd3152ab107, 0x51e21d24126e8563, 0xcba56cac884a1354, 0x39abb1b595f0a977, 0x95b9b814},
+	{0x99c175819b4eae28, 0x932e8ff9f7a40043, 0xec78dcab07ca9f7c, 0xc1a78b82ba815b74, 0x458cbdfc82eb322a, 0x17f4a192376ed8d7, 0x6f9e92968bc8ccef, 0x45fbe66e},
+	{0x2a418335779b82fc, 0xaf0295987849a76b, 0xc12bc5ff0213f46e, 0x5aeead8d6cb25bb9, 0x739315f7743ec3ff, 0x9ab48d27111d2dcc, 0x5b87bd35a975929b, 0xb4baa7a8},
+	{0x3b1fc6a3d279e67d, 0x70ea1e49c226396, 0x25505adcf104697c, 0xba1ffba29f0367aa, 0xa20bec1dd15a8b6c, 0xe9bf61d2dab0f774, 0xf4f35bf5870a049c, 0x83e962fe},
+	{0xd97eacdf10f1c3c9, 0xb54f4654043a36e0, 0xb128f6eb09d1234, 0xd8ad7ec84a9c9aa2, 0xe256cffed11f69e6, 0x2cf65e4958ad5bda, 0xcfbf9b03245989a7, 0xaac3531c},
+	{0x293a5c1c4e203cd4, 0x6b3329f1c130cefe, 0xf2e32f8ec76aac91, 0x361e0a62c8187bff, 0x6089971bb84d7133, 0x93df7741588dd50b, 0xc2a9b6abcd1d80b1, 0x2b1db7cc},
+	{0x4290e018ffaedde7, 0xa14948545418eb5e, 0x72d851b202284636, 0x4ec02f3d2f2b23f2, 0xab3580708aa7c339, 0xcdce066fbab3f65, 0xd8ed3ecf3c7647b9, 0xcf00cd31},
+	{0xf919a59cbde8bf2f, 0xa56d04203b2dc5a5, 0x38b06753ac871e48, 0xc2c9fc637dbdfcfa, 0x292ab8306d149d75, 0x7f436b874b9ffc07, 0xa5b56b0129218b80, 0x7d3c43b8},
+	{0x1d70a3f5521d7fa4, 0xfb97b3fdc5891965, 0x299d49bbbe3535af, 0xe1a8286a7d67946e, 0x52bd956f047b298, 0xcbd74332dd4204ac, 0x12b5be7752721976, 0xcbd5fac6},
+	{0x6af98d7b656d0d7c, 0xd2e99ae96d6b5c0c, 0xf63bd1603ef80627, 0xbde51033ac0413f8, 0xbc0272f691aec629, 0x6204332651bebc44, 0x1cbf00de026ea9bd, 0x76d0fec4},
+	{0x395b7a8adb96ab75, 0x582df7165b20f4a, 0xe52bd30e9ff657f9, 0x6c71064996cbec8b, 0x352c535edeefcb89, 0xac7f0aba15cd5ecd, 0x3aba1ca8353e5c60, 0x405e3402},
+	{0x3822dd82c7df012f, 0xb9029b40bd9f122b, 0xfd25b988468266c4, 0x43e47bd5bab1e0ef, 0x4a71f363421f282f, 0x880b2f32a2b4e289, 0x1299d4eda9d3eadf, 0xc732c481},
+	{0x79f7efe4a80b951a, 0xdd3a3fddfc6c9c41, 0xab4c812f9e27aa40, 0x832954ec9d0de333, 0x94c390aa9bcb6b8a, 0xf3b32afdc1f04f82, 0xd229c3b72e4b9a74, 0xa8d123c9},
+	{0xae6e59f5f055921a, 0xe9d9b7bf68e82, 0x5ce4e4a5b269cc59, 0x4960111789727567, 0x149b8a37c7125ab6, 0x78c7a13ab9749382, 0x1c61131260ca151a, 0x1e80ad7d},
+	{0x8959dbbf07387d36, 0xb4658afce48ea35d, 0x8f3f82437d8cb8d6, 0x6566d74954986ba5, 0x99d5235cc82519a7, 0x257a23805c2d825, 0xad75ccb968e93403, 0x52aeb863},
+	{0x4739613234278a49, 0x99ea5bcd340bf663, 0x258640912e712b12, 0xc8a2827404991402, 0x7ee5e78550f02675, 0x2ec53952db5ac662, 0x1526405a9df6794b, 0xef7c0c18},
+	{0x420e6c926bc54841, 0x96dbbf6f4e7c75cd, 0xd8d40fa70c3c67bb, 0x3edbc10e4bfee91b, 0xf0d681304c28ef68, 0x77ea602029aaaf9c, 0x90f070bd24c8483c, 0xb6ad4b68},
+	{0xc8601bab561bc1b7, 0x72b26272a0ff869a, 0x56fdfc986d6bc3c4, 0x83707730cad725d4, 0xc9ca88c3a779674a, 0xe1c696fbbd9aa933, 0x723f3baab1c17a45, 0xc1e46b17},
+	{0xb2d294931a0e20eb, 0x284ffd9a0815bc38, 0x1f8a103aac9bbe6, 0x1ef8e98e1ea57269, 0x5971116272f45a8b, 0x187ad68ce95d8eac, 0xe94e93ee4e8ecaa6, 0x57b8df25},
+	{0x7966f53c37b6c6d7, 0x8e6abcfb3aa2b88f, 0x7f2e5e0724e5f345, 0x3eeb60c3f5f8143d, 0xa25aec05c422a24f, 0xb026b03ad3cca4db, 0xe6e030028cc02a02, 0xe9fa36d6},
+	{0xbe9bb0abd03b7368, 0x13bca93a3031be55, 0xe864f4f52b55b472, 0x36a8d13a2cbb0939, 0x254ac73907413230, 0x73520d1522315a70, 0x8c9fdb5cf1e1a507, 0x8f8daefc},
+	{0xa08d128c5f1649be, 0xa8166c3dbbe19aad, 0xcb9f914f829ec62c, 0x5b2b7ca856fad1c3, 0x8093022d682e375d, 0xea5d163ba7ea231f, 0xd6181d012c0de641, 0x6e1bb7e},
+	{0x7c386f0ffe0465ac, 0x530419c9d843dbf3, 0x7450e3a4f72b8d8c, 0x48b218e3b721810d, 0xd3757ac8609bc7fc, 0x111ba02a88aefc8, 0xe86343137d3bfc2a, 0xfd0076f0},
+	{0xbb362094e7ef4f8, 0xff3c2a48966f9725, 0x55152803acd4a7fe, 0x15747d8c505ffd00, 0x438a15f391312cd6, 0xe46ca62c26d821f5, 0xbe78d74c9f79cb44, 0x899b17b6},
+	{0xcd80dea24321eea4, 0x52b4fdc8130c2b15, 0xf3ea100b154bfb82, 0xd9ccef1d4be46988, 0x5ede0c4e383a5e66, 0xda69683716a54d1e, 0xbfc3fdf02d242d24, 0xe3e84e31},
+	{0xd599a04125372c3a, 0x313136c56a56f363, 0x1e993c3677625832, 0x2870a99c76a587a4, 0x99f74cc0b182dda4, 0x8a5e895b2f0ca7b6, 0x3d78882d5e0bb1dc, 0xeef79b6b},
+	{0xdbbf541e9dfda0a, 0x1479fceb6db4f844, 0x31ab576b59062534, 0xa3335c417687cf3a, 0x92ff114ac45cda75, 0xc3b8a627384f13b5, 0xc4f25de33de8b3f7, 0x868e3315},
+	{0xc2ee3288be4fe2bf, 0xc65d2f5ddf32b92, 0xaf6ecdf121ba5485, 0xc7cd48f7abf1fe59, 0xce600656ace6f53a, 0x8a94a4381b108b34, 0xf9d1276c64bf59fb, 0x4639a426},
+	{0xd86603ced1ed4730, 0xf9de718aaada7709, 0xdb8b9755194c6535, 0xd803e1eead47604c, 0xad00f7611970a71b, 0xbc50036b16ce71f5, 0xafba96210a2ca7d6, 0xf3213646},
+	{0x915263c671b28809, 0xa815378e7ad762fd, 0xabec6dc9b669f559, 0xd17c928c5342477f, 0x745130b795254ad5, 0x8c5db926fe88f8ba, 0x742a95c953e6d974, 0x17f148e9},
+	{0x2b67cdd38c307a5e, 0xcb1d45bb5c9fe1c, 0x800baf2a02ec18ad, 0x6531c1fe32bcb417, 0x8c970d8df8cdbeb4, 0x917ba5fc67e72b40, 0x4b65e4e263e0a426, 0xbfd94880},
+	{0x2d107419073b9cd0, 0xa96db0740cef8f54, 0xec41ee91b3ecdc1b, 0xffe319654c8e7ebc, 0x6a67b8f13ead5a72, 0x6dd10a34f80d532f, 0x6e9cfaece9fbca4, 0xbb1fa7f3},
+	{0xf3e9487ec0e26dfc, 0x1ab1f63224e837fa, 0x119983bb5a8125d8, 0x8950cfcf4bdf622c, 0x8847dca82efeef2f, 0x646b75b026708169, 0x21cab4b1687bd8b, 0x88816b1},
+	{0x1160987c8fe86f7d, 0x879e6db1481eb91b, 0xd7dcb802bfe6885d, 0x14453b5cc3d82396, 0x4ef700c33ed278bc, 0x1639c72ffc00d12e, 0xfb140ee6155f700d, 0x5c2faeb3},
+	{0xeab8112c560b967b, 0x97f550b58e89dbae, 0x846ed506d304051f, 0x276aa37744b5a028, 0x8c10800ee90ea573, 0xe6e57d2b33a1e0b7, 0x91f83563cd3b9dda, 0x51b5fc6f},
+	{0x1addcf0386d35351, 0xb5f436561f8f1484, 0x85d38e22181c9bb1, 0xff5c03f003c1fefe, 0xe1098670afe7ff6, 0xea445030cf86de19, 0xf155c68b5c2967f8, 0x33d94752},
+	{0xd445ba84bf803e09, 0x1216c2497038f804, 0x2293216ea2237207, 0xe2164451c651adfb, 0xb2534e65477f9823, 0x4d70691a69671e34, 0x15be4963dbde8143, 0xb0c92948},
+	{0x37235a096a8be435, 0xd9b73130493589c2, 0x3b1024f59378d3be, 0xad159f542d81f04e, 0x49626a97a946096, 0xd8d3998bf09fd304, 0xd127a411eae69459, 0xc7171590},
+	{0x763ad6ea2fe1c99d, 0xcf7af5368ac1e26b, 0x4d5e451b3bb8d3d4, 0x3712eb913d04e2f2, 0x2f9500d319c84d89, 0x4ac6eb21a8cf06f9, 0x7d1917afcde42744, 0x240a67fb},
+	{0xea627fc84cd1b857, 0x85e372494520071f, 0x69ec61800845780b, 0xa3c1c5ca1b0367, 0xeb6933997272bb3d, 0x76a72cb62692a655, 0x140bb5531edf756e, 0xe1843cd5},
+	{0x1f2ffd79f2cdc0c8, 0x726a1bc31b337aaa, 0x678b7f275ef96434, 0x5aa82bfaa99d3978, 0xc18f96cade5ce18d, 0x38404491f9e34c03, 0x891fb8926ba0418c, 0xfda1452b},
+	{0x39a9e146ec4b3210, 0xf63f75802a78b1ac, 0xe2e22539c94741c3, 0x8b305d532e61226e, 0xcaeae80da2ea2e, 0x88a6289a76ac684e, 0x8ce5b5f9df1cbd85, 0xa2cad330},
+	{0x74cba303e2dd9d6d, 0x692699b83289fad1, 0xdfb9aa7874678480, 0x751390a8a5c41bdc, 0x6ee5fbf87605d34, 0x6ca73f610f3a8f7c, 0xe898b3c996570ad, 0x53467e16},
+	{0x4cbc2b73a43071e0, 0x56c5db4c4ca4e0b7, 0x1b275a162f46bd3d, 0xb87a326e413604bf, 0xd8f9a5fa214b03ab, 0x8a8bb8265771cf88, 0xa655319054f6e70f, 0xda14a8d0},
+	{0x875638b9715d2221, 0xd9ba0615c0c58740, 0x616d4be2dfe825aa, 0x5df25f13ea7bc284, 0x165edfaafd2598fb, 0xaf7215c5c718c696, 0xe9f2f9ca655e769, 0x67333551},
+	{0xfb686b2782994a8d, 0xedee60693756bb48, 0xe6bc3cae0ded2ef5, 0x58eb4d03b2c3ddf5, 0x6d2542995f9189f1, 0xc0beec58a5f5fea2, 0xed67436f42e2a78b, 0xa0ebd66e},
+	{0xab21d81a911e6723, 0x4c31b07354852f59, 0x835da384c9384744, 0x7f759dddc6e8549a, 0x616dd0ca022c8735, 0x94717ad4bc15ceb3, 0xf66c7be808ab36e, 0x4b769593},
+	{0x33d013cc0cd46ecf, 0x3de726423aea122c, 0x116af51117fe21a9, 0xf271ba474edc562d, 0xe6596e67f9dd3ebd, 0xc0a288edf808f383, 0xb3def70681c6babc, 0x6aa75624},
+	{0x8ca92c7cd39fae5d, 0x317e620e1bf20f1, 0x4f0b33bf2194b97f, 0x45744afcf131dbee, 0x97222392c2559350, 0x498a19b280c6d6ed, 0x83ac2c36acdb8d49, 0x602a3f96},
+	{0xfdde3b03f018f43e, 0x38f932946c78660, 0xc84084ce946851ee, 0xb6dd09ba7851c7af, 0x570de4e1bb13b133, 0xc4e784eb97211642, 0x8285a7fcdcc7c58d, 0xcd183c4d},
+	{0x9c8502050e9c9458, 0xd6d2a1a69964beb9, 0x1675766f480229b5, 0x216e1d6c86cb524c, 0xd01cf6fd4f4065c0, 0xfffa4ec5b482ea0f, 0xa0e20ee6a5404ac1, 0x960a4d07},
+	{0x348176ca2fa2fdd2, 0x3a89c514cc360c2d, 0x9f90b8afb318d6d0, 0xbceee07c11a9ac30, 0x2e2d47dff8e77eb7, 0x11a394cd7b6d614a, 0x1d7c41d54e15cb4a, 0x9ae998c4},
+	{0x4a3d3dfbbaea130b, 0x4e221c920f61ed01, 0x553fd6cd1304531f, 0xbd2b31b5608143fe, 0xab717a10f2554853, 0x293857f04d194d22, 0xd51be8fa86f254f0, 0x74e2179d},
+	{0xb371f768cdf4edb9, 0xbdef2ace6d2de0f0, 0xe05b4100f7f1baec, 0xb9e0d415b4ebd534, 0xc97c2a27efaa33d7, 0x591cdb35f84ef9da, 0xa57d02d0e8e3756c, 0xee9bae25},
+	{0x7a1d2e96934f61f, 0xeb1760ae6af7d961, 0x887eb0da063005df, 0x2228d6725e31b8ab, 0x9b98f7e4d0142e70, 0xb6a8c2115b8e0fe7, 0xb591e2f5ab9b94b1, 0xb66edf10},
+	{0x8be53d466d4728f2, 0x86a5ac8e0d416640, 0x984aa464cdb5c8bb, 0x87049e68f5d38e59, 0x7d8ce44ec6bd7751, 0xcc28d08ab414839c, 0x6c8f0bd34fe843e3, 0xd6209737},
+	{0x829677eb03abf042, 0x43cad004b6bc2c0, 0xf2f224756803971a, 0x98d0dbf796480187, 0xfbcb5f3e1bef5742, 0x5af2a0463bf6e921, 0xad9555bf0120b3a3, 0xb994a88},
+	{0x754435bae3496fc, 0x5707fc006f094dcf, 0x8951c86ab19d8e40, 0x57c5208e8f021a77, 0xf7653fbb69cd9276, 0xa484410af21d75cb, 0xf19b6844b3d627e8, 0xa05d43c0},
+	{0xfda9877ea8e3805f, 0x31e868b6ffd521b7, 0xb08c90681fb6a0fd, 0x68110a7f83f5d3ff, 0x6d77e045901b85a8, 0x84ef681113036d8b, 0x3b9f8e3928f56160, 0xc79f73a8},
+	{0x2e36f523ca8f5eb5, 0x8b22932f89b27513, 0x331cd6ecbfadc1bb, 0xd1bfe4df12b04cbf, 0xf58c17243fd63842, 0x3a453cdba80a60af, 0x5737b2ca7470ea95, 0xa490aff5},
+	{0x21a378ef76828208, 0xa5c13037fa841da2, 0x506d22a53fbe9812, 0x61c9c95d91017da5, 0x16f7c83ba68f5279, 0x9c0619b0808d05f7, 0x83c117ce4e6b70a3, 0xdfad65b4},
+	{0xccdd5600054b16ca, 0xf78846e84204cb7b, 0x1f9faec82c24eac9, 0x58634004c7b2d19a, 0x24bb5f51ed3b9073, 0x46409de018033d00, 0x4a9805eed5ac802e, 0x1d07dfb},
+	{0x7854468f4e0cabd0, 0x3a3f6b4f098d0692, 0xae2423ec7799d30d, 0x29c3529eb165eeba, 0x443de3703b657c35, 0x66acbce31ae1bc8d, 0x1acc99effe1d547e, 0x416df9a0},
+	{0x7f88db5346d8f997, 0x88eac9aacc653798, 0x68a4d0295f8eefa1, 0xae59ca86f4c3323d, 0x25906c09906d5c4c, 0x8dd2aa0c0a6584ae, 0x232a7d96b38f40e9, 0x1f8fb9cc},
+	{0xbb3fb5fb01d60fcf, 0x1b7cc0847a215eb6, 0x1246c994437990a1, 0xd4edc954c07cd8f3, 0x224f47e7c00a30ab, 0xd5ad7ad7f41ef0c6, 0x59e089281d869fd7, 0x7abf48e3},
+	{0x2e783e1761acd84d, 0x39158042bac975a0, 0x1cd21c5a8071188d, 0xb1b7ec44f9302176, 0x5cb476450dc0c297, 0xdc5ef652521ef6a2, 0x3cc79a9e334e1f84, 0xdea4e3dd},
+	{0x392058251cf22acc, 0x944ec4475ead4620, 0xb330a10b5cb94166, 0x54bc9bee7cbe1767, 0x485820bdbe442431, 0x54d6120ea2972e90, 0xf437a0341f29b72a, 0xc6064f22},
+	{0xadf5c1e5d6419947, 0x2a9747bc659d28aa, 0x95c5b8cb1f5d62c, 0x80973ea532b0f310, 0xa471829aa9c17dd9, 0xc2ff3479394804ab, 0x6bf44f8606753636, 0x743bed9c},
+	{0x6bc1db2c2bee5aba, 0xe63b0ed635307398, 0x7b2eca111f30dbbc, 0x230d2b3e47f09830, 0xec8624a821c1caf4, 0xea6ec411cdbf1cb1, 0x5f38ae82af364e27, 0xfce254d5},
+	{0xb00f898229efa508, 0x83b7590ad7f6985c, 0x2780e70a0592e41d, 0x7122413bdbc94035, 0xe7f90fae33bf7763, 0x4b6bd0fb30b12387, 0x557359c0c44f48ca, 0xe47ec9d1},
+	{0xb56eb769ce0d9a8c, 0xce196117bfbcaf04, 0xb26c3c3797d66165, 0x5ed12338f630ab76, 0xfab19fcb319116d, 0x167f5f42b521724b, 0xc4aa56c409568d74, 0x334a145c},
+	{0x70c0637675b94150, 0x259e1669305b0a15, 0x46e1dd9fd387a58d, 0xfca4e5bc9292788e, 0xcd509dc1facce41c, 0xbbba575a59d82fe, 0x4e2e71c15b45d4d3, 0xadec1e3c},
+	{0x74c0b8a6821faafe, 0xabac39d7491370e7, 0xfaf0b2a48a4e6aed, 0x967e970df9673d2a, 0xd465247cffa415c0, 0x33a1df0ca1107722, 0x49fc2a10adce4a32, 0xf6a9fbf8},
+	{0x5fb5e48ac7b7fa4f, 0xa96170f08f5acbc7, 0xbbf5c63d4f52a1e5, 0x6cc09e60700563e9, 0xd18f23221e964791, 0xffc23eeef7af26eb, 0x693a954a3622a315, 0x5398210c},
 }
 
-func BenchmarkJenkins32(b *testing.B) {
-	commonBench(b, NewJenkins32(), goldenJenkins)
+// The Fingerprint64 self-test table from the reference farmhash.cc, as
+// pairs of (high, low) 32-bit halves.
+var farmFingerprint64Reference = []uint32{
+	2598464059, 797982799, 1410420968, 2134990486, 255297188, 2992121793, 4019337850, 452431531,
+	299850021, 2532580744, 2199864459, 3696623795, 1053458494, 1882212500, 458884671, 3033004529,
+	2700149065, 2699376854, 4220361840, 1712034059, 594028478, 2921867846, 3280331829, 326029180,
+	3824583307, 1612122221, 2233466664, 1432476832, 1628777059, 1499109081, 1145519619, 3190844552,
+	65721842, 489963606, 1790705123, 2128624475, 155445229, 1672724608, 3610042449, 1911523866,
+	1099072299, 1389770549, 3603803785, 629449419, 1552847036, 645684964, 3151491850, 3272648435,
+	916494250, 1230085527, 231181488, 851743255, 1142264800, 3667013118, 732137533, 1909203251,
+	4072067757, 4165088768, 956300927, 914413116, 3074915312, 3117299654, 1438494951, 507436733,
+	126024219, 146044391, 165589978, 1578546616, 249776086, 1207522198, 46987739, 1157614300,
+	3614377032, 586863115, 1164298657, 4140791139, 3725511003, 232064808, 512845449, 3748861010,
+	22638523, 648000590, 1024246061, 4027776454, 411505255, 1973395102, 3474970689, 1029055034,
+	589567754, 325737734, 257578986, 3698087965, 2305332220, 191910725, 3315355162, 2135941665,
+	23075771, 3252374102, 663013031, 3444053918, 2115441882, 4081398201, 1379288194, 4225182569,
+	3667516477, 1709989541, 2725013602, 3639843023, 2470483982, 877580602, 3981838403, 3762572073,
+	1129162571, 732225574, 3232041815, 1652884780, 2227121257, 1426140634, 1386256573, 24035717,
+	1598686658, 3146815575, 739944537, 579625482, 3903349120, 389846205, 2834153464, 1481069623,
+	3740748788, 3388062747, 1020177209, 734239551, 2610427744, 49703572, 1416453607, 2815915291,
+	937074653, 3035635454, 3711259084, 2627383582, 3669691805, 263366740, 3565059103, 1190977418,
+	2747519432, 4129538640, 2271095827, 2993032712, 795918276, 1116991810, 937372240, 1343017609,
+	1166522068, 1623631848, 2721658101, 1937681565, 114616703, 954762543, 1756889687, 2936126607,
+	2483004780, 1927385370, 1672737098, 2148675559, 2636210123, 1338083267, 1335160250, 2084630531,
+	2746885618, 636616055, 2076016059, 408721884, 2301682622, 2691859523, 2614088922, 1975527044,
+	3529473373, 1490330107, 4271796078, 1910401882, 3738454258, 2554452696, 2237827073, 2803250686,
+	1996680960, 839529273, 3544595875, 3909443124, 3656063205, 837475154, 438095290, 484603494,
+	308425103, 268427550, 4243643405, 2849988118, 2948254999, 2102063419, 1735616066, 1539151988,
+	95237878, 2005032160, 1433635018, 116647396, 881378302, 2159170082, 336034862, 2017579106,
+	944743644, 1694443528, 260177668, 505662155, 3722741628, 1511077569, 1103819072, 2089123665,
+	2475035432, 1120017626, 2842141483, 4029205195, 3873078673, 136118734, 1699452298, 1403506686,
+	1805475756, 2562064338, 4271866024, 3071338162, 459509140, 771592405, 185232757, 4032960199,
+	3512945009, 308584855, 4250142168, 2565680167, 38924274, 3770488806, 3099963860, 1255084262,
+	2363435042, 54945052, 2534883189, 2432427547, 2741583197, 1280920000, 1281043691, 1121403845,
+	2127558730, 713121337, 2108187161, 927011680, 4134691985, 1958963937, 2567532373, 4075249328,
+	4104757832, 3026358429, 3573008472, 3615577014, 1541946015, 3087190425, 857839960, 2515339233,
+	2809830736, 460237542, 1950698961, 2069753399, 1106466069, 356742959, 3662626864, 1750561299,
+	992181339, 3384018814, 100741310, 451656820, 3650357479, 2390172694, 2088767754, 164402616,
+	2751052984, 1767810825, 3441135892, 3323383489, 2756998822, 207428029, 2648427775, 2360400900,
+	1396468647, 1377764574, 1435134775, 1099809675, 3374512975, 3542220540, 4081637863, 337070226,
+	644850146, 1306761320, 1242645122, 4109252858, 3377483696, 1788337208, 1658628529, 2911512007,
+	367022558, 3071359622, 4273132307, 3898950547, 1858986613, 2040551642, 4077477194, 3565689036,
+	265993036, 1864569342, 923017956, 490608221, 3833372385, 3287246572, 2649450292, 500120236,
+	2810524030, 1561519055, 3224066062, 2774151984, 2107011431, 96459446, 1235983679, 4237425634,
+	276949224, 4100839753, 427484362, 4246879223, 1858777639, 3476334357, 358032121, 2511026735,
+	1535473864, 556796152, 1476438092, 2913077464, 3051522276, 4046477658, 1802040304, 990407433,
+	4052924496, 2926590471, 4265214507, 82077489, 464407878, 4190838199, 733509243, 1583801700,
+	1877837196, 3912423882, 8759461, 2540185277, 2019419351, 4051584612, 700836153, 1675560450,
+	3130433948, 405251683, 2224044848, 4071581802, 2272418128, 803575837, 4019147902, 3841480082,
+	3424361375, 779434428, 3057021940, 2285701422, 1783152480, 823305654, 3032187389, 4159715581,
+	3420960112, 3198900547, 3006227299, 4194096960, 1775955687, 1719108984, 684087286, 531310503,
+	3105682208, 3382290593, 777173623, 3241407531, 2649684057, 1397502982, 3193669211, 811750340,
+	3403136990, 2540585554, 784952939, 943914610, 3985088434, 1911188923, 519948041, 3181425568,
+	1089679033, 240953857, 3017658263, 3828377737, 308018483, 4262383425, 3188015819, 4051263539,
+	4074952232, 1683612329, 206775997, 2283918569, 2217060665, 350160869, 140980, 1891558063,
+	422986366, 330624974, 918718096, 376390582, 3424344721, 3187805406, 3855037968, 1928519266,
+	3059200728, 2108753646, 1343511943, 2247006571, 622521957, 917121602, 3299763344, 2864033668,
+	2661022773, 2006922227, 1237256330, 3449066284, 3285899651, 786322314, 1244759631, 3263135197,
+	987586766, 3206261120, 1827135136, 1781944746, 2482286699, 1109175923, 4190721328, 1129462471,
+	1623777358, 3389003793, 1646071378, 1164309901, 989577914, 3626554867, 1516846461, 3656006011,
+	3698796465, 3155218919, 1237411891, 1854985978, 3939149151, 878608872, 2437686324, 3163786257,
+	1235300371, 1256485167, 1883344352, 2083771672, 3066325351, 2770847216, 601221482, 3992583643,
+	2557027816, 900741486, 90375300, 300318232, 3253901179, 542270815, 1273768482, 1216399252,
+	325675502, 3652676161, 1097584090, 3262252593, 3704419305, 411263051, 3460621305, 1967599860,
+	901109753, 2682611693, 797089608, 3286110054, 2219863904, 3623364733, 3061255808, 1615375832,
+	2701956286, 4145497671, 449740816, 2686506989, 1235084019, 2151665147, 2091754612, 1178454681,
+	3213794286, 2601416506, 4004834921, 238887261, 186020771, 2367569534, 1962659444, 3539886328,
+	2144472852, 1390394371, 3597555910, 3188438773, 3371014971, 2058751609, 1169588594, 857915866,
+	923161569, 4068653043, 3808667664, 581227317, 2077539039, 851579036, 2794103714, 2094375930,
+	3122317317, 2365436865, 2023960931, 2312244996, 612094988, 1555465129, 3306195841, 1702313921,
+	1171351291, 2043136409, 3744621107, 1028502697, 6114625, 3359104346, 1024572712, 1927582962,
+	3392622118, 1347167673, 2075035198, 4202817168, 701024148, 1481965992, 1334816273, 2870251538,
+	1010064531, 713520765, 4089081247, 3231042924, 2452539325, 1343734533, 587001593, 1917607088,
+	3498936874, 246692543, 2836854664, 2317249321, 774652981, 1285694082, 397012087, 1717527689,
+	2904461070, 3893453420, 1565179401, 600903026, 1134342772, 3234226304, 345572299, 2274770442,
+	1079209397, 2122849632, 1242840526, 3987000643, 3065138774, 3111336863, 1023721001, 3763083325,
+	2196937373, 2643841788, 4201389782, 4223278891, 292733931, 1424229089, 2927147928, 1048291071,
+	2490333812, 4098360768, 3948800722, 335456628, 540133451, 3313113759, 3430536378, 2514123129,
+	2418881542, 487365389, 1136054817, 3004241477, 4109233936, 3679809321, 3527024461, 1147434678,
+	3308746763, 1875093248, 4217929592, 400784472, 160353261, 2413172925, 1853298225, 3201741245,
+	3680311316, 4274382900, 1131020455, 194781179, 3440090658, 2165746386, 3106421434, 880320527,
+	1429837716, 252230074, 3623657004, 3869801679, 2507199021, 1659221866, 3121647246, 3884308578,
+	2610217849, 641564641, 329123979, 121860586, 947795261, 1992594155, 3050771207, 2767035539,
+	627269409, 1806905031, 584050483, 4142579188, 3259749808, 644172091, 3053081915, 2840648309,
+	2244943480, 4057483496, 873421687, 2447660175, 1233635843, 2163464207, 2515400215, 3100476924,
+	470325051, 2598261204, 850667549, 3622479237, 2781907007, 943739431, 1901484772, 939810041,
+	3261383939, 2212130277, 3349254805, 2796552902, 3372846298, 3835884044, 2764936304, 1338171648,
+	2525665319, 4196233786, 2290169528, 1793910997, 1554419340, 1733094688, 1084699349, 3233936866,
+	1428704144, 3269904970, 3347011944, 1892898231, 1072588531, 3547435717, 1593338562, 919414554,
+	3953006207, 877438080, 224271045, 2914958001, 2920583824, 1251814062, 385182008, 640855184,
+	4263183176, 3041193150, 3505072908, 2830570613, 1949847968, 2999344380, 1714496583, 15918244,
+	2605688266, 3253705097, 4152736859, 2097020806, 2122199776, 1069285218, 670591796, 768977505,
+	379861934, 1557579480, 547346027, 388559045, 1495176194, 4093461535, 1911655402, 1053371241,
+	3717104621, 1144474110, 4166253320, 2747410691,
 }
 
-func BenchmarkMarvin32(b *testing.B) {
-	commonBench(b, NewMarvin32(0), goldenMarvin)
+var goldenSpookyHash128 = []_Golden128{
+	{0x232706fc6bf50919, 0x8b72ee65b4e851c7, ""},
+	{0x1a108191a0bbc9bd, 0x754258f061412a92, "a"},
+	{0xf9dbb6ad202a090f, 0x9c7059b0dad5ae93, "ab"},
+	{0x8aab15f77537c967, 0xc61367f8ca7811b0, "abc"},
+	{0x5c6db4e0725121b4, 0xed4d2a6bf05f6d02, "abcd"},
+	{0x4d36bf2cf609ea58, 0x19b91b8a95e63aad, "abcde"},
+	{0xe58acc6ac1806d46, 0xe883303f848b6936, "abcdef"},
+	{0xe134fb62c64ba57e, 0x82370d1a277e05e1, "abcdefg"},
+	{0x101c8730a539eb6e, 0xa8f6b7fcf2cdf12e, "abcdefgh"},
+	{0xc07b3e2b5b2b9088, 0x325a9a3d862222a3, "abcdefghi"},
+	{0x386052dd535ad608, 0x70e7f39d49914037, "abcdefghij"},
+	{0x9a2a8b03f065d989, 0x75d5e55d5d40fec5, "Discard medicine more than two years old."},
+	{0x3cfae0aeec123aec, 0xd7735cc02943acc7, "He who has a shady past knows that nice guys finish last."},
+	{0x5cbb087a111a27e3, 0xfea6b212c325888e, "I wouldn't marry him with a ten foot pole."},
+	{0xefe646e8b596c5a9, 0xdb63dd14e1c109b3, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x9cb53539a011d4d0, 0xde43b8d544062cfe, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x1c00acb8421bf55b, 0xc1153d81ce2f097f, "Nepal premier won't resign."},
+	{0xd2c7109575a957b5, 0x9e154405d06f0ba0, "For every action there is an equal and opposite government program."},
+	{0x051a1c6d0f021f09, 0xe3d3136e05eb3fbc, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x27d9177ee154640f, 0x8059a9f29745ad82, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x9a6dee0bcd39c963, 0xdf667ddcb5800dea, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x8f7e2206aca8d3e0, 0xbd8bec5d6fc0c3a5, "size:  a.out:  bad magic"},
+	{0xfd9d590950522f31, 0x80406cf282d5b68a, "The major problem is with sendmail.  -Mark Horton"},
+	{0xc3359ca69be20184, 0xff5c3bb7100ffd49, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xd3f4f61c514cf6ca, 0x05a6b22a5b786528, "If the enemy is within range, then so are you."},
+	{0x13e371bb70e685b5, 0xb393f60c6d429307, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x713c48c45813353f, 0xe9f202f216136ed1, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x9c3d456bfc68f45d, 0xf928cfd4cd627bd1, "C is as portable as Stonehedge!!"},
+	{0x57fde96671cf1fc1, 0x55dcd3066faebcb4, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x995fd7a42818a4d4, 0x781feff2e19c18ca, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x1793448a145061e7, 0xb89f4daa42c9a030, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func BenchmarkMurmur(b *testing.B) {
-	commonBench(b, NewMurmur3_x86_32(), goldenMurmur3)
+var goldenJenkinsLookup2 = []_Golden{
+	{0xbd49d10d, ""},
+	{0x29eec818, "a"},
+	{0x9879ac41, "ab"},
+	{0x251e4793, "abc"},
+	{0x5ae61fa5, "abcd"},
+	{0x03a96866, "abcde"},
+	{0xde922732, "abcdef"},
+	{0xb9e6762c, "abcdefg"},
+	{0x053f775e, "abcdefgh"},
+	{0x3a7b0a5f, "abcdefghi"},
+	{0xc9cac242, "abcdefghij"},
+	{0x0f169869, "Discard medicine more than two years old."},
+	{0x0ddd1e35, "He who has a shady past knows that nice guys finish last."},
+	{0xf7ee7c30, "I wouldn't marry him with a ten foot pole."},
+	{0x7d96bc3c, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xf80a1eee, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x0427cc46, "Nepal premier won't resign."},
+	{0x22298a67, "For every action there is an equal and opposite government program."},
+	{0xc25363b5, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x16d756ad, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xe7a366bb, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x47417caf, "size:  a.out:  bad magic"},
+	{0x915c950e, "The major problem is with sendmail.  -Mark Horton"},
+	{0x1a2fbf8f, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x0b323797, "If the enemy is within range, then so are you."},
+	{0x7652f2f8, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xe4d502d0, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xb774f0de, "C is as portable as Stonehedge!!"},
+	{0xbf73b366, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x2c37a681, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xf5dee043, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func BenchmarkSDBM32(b *testing.B) {
-	commonBench(b, NewSDBM32(), goldenSdbm)
+var goldenJenkinsHashLittle = []_Golden{
+	{0xdeadbeef, ""},
+	{0x58d68708, "a"},
+	{0xfbb3a8df, "ab"},
+	{0x0e397631, "abc"},
+	{0xb5f4889c, "abcd"},
+	{0x026d72de, "abcde"},
+	{0xd6fa502e, "abcdef"},
+	{0xb11ad4a5, "abcdefg"},
+	{0x2995c3be, "abcdefgh"},
+	{0xac6572b4, "abcdefghi"},
+	{0x8bf7d2ef, "abcdefghij"},
+	{0x0df3e0ad, "Discard medicine more than two years old."},
+	{0x9e8d7cae, "He who has a shady past knows that nice guys finish last."},
+	{0x8cd3a9b1, "I wouldn't marry him with a ten foot pole."},
+	{0x51a0609e, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x23db8959, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x8248ce26, "Nepal premier won't resign."},
+	{0x9cb0d012, "For every action there is an equal and opposite government program."},
+	{0x120e1093, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x4bfe16b6, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xbd958719, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x0bf51bf9, "size:  a.out:  bad magic"},
+	{0xaa4b0cff, "The major problem is with sendmail.  -Mark Horton"},
+	{0xe5891c1b, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x1272b7d7, "If the enemy is within range, then so are you."},
+	{0x43ca8ddf, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x8e946eae, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x3bbfb33a, "C is as portable as Stonehedge!!"},
+	{0x931cc37b, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x54363bba, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x17652482, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func BenchmarkSQLite32(b *testing.B) {
-	commonBench(b, NewSQLite32(), goldenSqlite)
+var goldenJenkinsHashLittle2 = []_Golden64{
+	{0xdeadbeefdeadbeef, ""},
+	{0x582647ac58d68708, "a"},
+	{0x6b79a0f2fbb3a8df, "ab"},
+	{0x3c03be9e0e397631, "abc"},
+	{0xe20dd3fab5f4889c, "abcd"},
+	{0x03cd18df026d72de, "abcde"},
+	{0x23a820a4d6fa502e, "abcdef"},
+	{0x59ac1d9db11ad4a5, "abcdefg"},
+	{0xc79695242995c3be, "abcdefgh"},
+	{0xd99384ffac6572b4, "abcdefghi"},
+	{0x4e0e39008bf7d2ef, "abcdefghij"},
+	{0xa54dca8b0df3e0ad, "Discard medicine more than two years old."},
+	{0xbe8dae789e8d7cae, "He who has a shady past knows that nice guys finish last."},
+	{0x54eea8ae8cd3a9b1, "I wouldn't marry him with a ten foot pole."},
+	{0xc54880e951a0609e, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x8746891323db8959, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xaa393ec88248ce26, "Nepal premier won't resign."},
+	{0x74cddbcb9cb0d012, "For every action there is an equal and opposite government program."},
+	{0xf87c2cdc120e1093, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xcd57db3b4bfe16b6, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x93efbca2bd958719, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x679f4a250bf51bf9, "size:  a.out:  bad magic"},
+	{0x78eb8467aa4b0cff, "The major problem is with sendmail.  -Mark Horton"},
+	{0xacf1d1a6e5891c1b, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x545cda781272b7d7, "If the enemy is within range, then so are you."},
+	{0xa6b917f143ca8ddf, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x4e2e93168e946eae, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x4be526933bbfb33a, "C is as portable as Stonehedge!!"},
+	{0x185afd51931cc37b, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xb7e37fd554363bba, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x1c9d8af717652482, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func BenchmarkSuperFastHash(b *testing.B) {
-	commonBench(b, NewSuperFastHash(), goldenSuperfast)
+var goldenJenkinsHashBig = []_Golden{
+	{0xdeadbeef, ""},
+	{0xe4ecaa40, "a"},
+	{0xe1354f9b, "ab"},
+	{0xb94b42a0, "abc"},
+	{0x9fd986e3, "abcd"},
+	{0xf69eef3f, "abcde"},
+	{0xad26837f, "abcdef"},
+	{0xb3b8d0f3, "abcdefg"},
+	{0x6dc39bd2, "abcdefgh"},
+	{0x33f760c1, "abcdefghi"},
+	{0xf46bcde4, "abcdefghij"},
+	{0xb63048e3, "Discard medicine more than two years old."},
+	{0x01b34f9c, "He who has a shady past knows that nice guys finish last."},
+	{0x1bdc0f8c, "I wouldn't marry him with a ten foot pole."},
+	{0x8aa5057d, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x85c5b427, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xf9d6605a, "Nepal premier won't resign."},
+	{0x9ab083ec, "For every action there is an equal and opposite government program."},
+	{0xea7cdd51, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xf2f40715, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x1948ce9a, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x4deb9666, "size:  a.out:  bad magic"},
+	{0x725223ed, "The major problem is with sendmail.  -Mark Horton"},
+	{0xccc0afb6, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xcc3a7ea1, "If the enemy is within range, then so are you."},
+	{0xb0dbe9b1, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xd6f48492, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x3140cafd, "C is as portable as Stonehedge!!"},
+	{0xd999ad28, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x93d826d6, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xe3bbed51, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func commonBench(b *testing.B, h hash.Hash32, golden []_Golden) {
-	for i := 0; i < b.N; i++ {
-		for _, g := range golden {
-			h.Reset()
-			h.Write([]byte(g.in))
-			h.Sum32()
-		}
-	}
+var goldenPearson8 = []_Golden{
+	{0x00, ""},
+	{0x12, "a"},
+	{0x61, "ab"},
+	{0x7c, "abc"},
+	{0xaa, "abcd"},
+	{0x01, "abcde"},
+	{0x03, "abcdef"},
+	{0xd5, "abcdefg"},
+	{0x32, "abcdefgh"},
+	{0x52, "abcdefghi"},
+	{0xe6, "abcdefghij"},
+	{0x0d, "Discard medicine more than two years old."},
+	{0xe3, "He who has a shady past knows that nice guys finish last."},
+	{0x1c, "I wouldn't marry him with a ten foot pole."},
+	{0x32, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x6d, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xcc, "Nepal premier won't resign."},
+	{0x5e, "For every action there is an equal and opposite government program."},
+	{0x13, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x3a, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x63, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xef, "size:  a.out:  bad magic"},
+	{0x85, "The major problem is with sendmail.  -Mark Horton"},
+	{0xe5, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xc8, "If the enemy is within range, then so are you."},
+	{0xfe, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x9c, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xa6, "C is as portable as Stonehedge!!"},
+	{0xd4, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x1b, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xb8, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func testIncremental(t *testing.T, h hash.Hash32, result uint32, which string) {
+var goldenPearson64 = []_Golden64{
+	{0x0000000000000000, ""},
+	{0x123365d5fbbd032d, "a"},
+	{0x615e71808890129b, "ab"},
+	{0x7cf192825b9f5d3e, "abc"},
+	{0xaab21a77f6f7431f, "abcd"},
+	{0x0172979254e1585c, "abcde"},
+	{0x03bf150b5ac05704, "abcdef"},
+	{0xd5202714f135b065, "abcdefg"},
+	{0x32789b6488b620a2, "abcdefgh"},
+	{0x522669a27590534b, "abcdefghi"},
+	{0xe6084e17dc0e43a1, "abcdefghij"},
+	{0x0df58aabe519d8a4, "Discard medicine more than two years old."},
+	{0xe38fb155c8867c41, "He who has a shady past knows that nice guys finish last."},
+	{0x1c7e52a8902aa5ac, "I wouldn't marry him with a ten foot pole."},
+	{0x32fee95c9abd5a57, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x6dadb73220a9ae44, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xccd41ebef5de399b, "Nepal premier won't resign."},
+	{0x5edbb93bd70ef759, "For every action there is an equal and opposite government program."},
+	{0x13b8e321b33897c2, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x3a182c779d375e45, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x632643fac1cc6b23, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xef7d5209f80ee149, "size:  a.out:  bad magic"},
+	{0x856ba650a881ec31, "The major problem is with sendmail.  -Mark Horton"},
+	{0xe566cb76ec671c9a, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xc8d2bd9eb0efbe25, "If the enemy is within range, then so are you."},
+	{0xfec69ab8d9326f46, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x9c2c63464a623592, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xa6aebe765eb7884c, "C is as portable as Stonehedge!!"},
+	{0xd429a57bf4d00736, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x1bf60b48cc5f1ee6, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xb882dff8dc43520d, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	h.Reset()
+var goldenWyHash = []_Golden64{
+	{0x93228a4de0eec5a2, ""},
+	{0xaced12527fe5bff8, "a"},
+	{0xe9c28c2968258c7d, "ab"},
+	{0x989b4a209c1011c9, "abc"},
+	{0x6d9a9834037410eb, "abcd"},
+	{0x66e6c1ed15f1f9ea, "abcde"},
+	{0x90c9ed91822a3bf0, "abcdef"},
+	{0x5b183f3d4acb6fd8, "abcdefg"},
+	{0xb9a4994f5b68615c, "abcdefgh"},
+	{0xd91779aa91afa226, "abcdefghi"},
+	{0x11c2cd68f070aa36, "abcdefghij"},
+	{0xb37781fefebd633c, "Discard medicine more than two years old."},
+	{0xcd0a64fcd1f139bd, "He who has a shady past knows that nice guys finish last."},
+	{0xae660d3b0f3c50e5, "I wouldn't marry him with a ten foot pole."},
+	{0xfee913ab0e815456, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x5190088c21774940, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xa7f05051b84d5897, "Nepal premier won't resign."},
+	{0x7468cb8901a9b633, "For every action there is an equal and opposite government program."},
+	{0x9454d5767cd14b96, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x35fb42c30fdee54a, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xa9e3c090f06557ec, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x6c500e3dc0351111, "size:  a.out:  bad magic"},
+	{0x9d6e49b97c56e69b, "The major problem is with sendmail.  -Mark Horton"},
+	{0x8949ccc2ef50944b, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x0b47a57c38d366cb, "If the enemy is within range, then so are you."},
+	{0xa134c6a81c82c2ed, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x2ab43d89587bdd76, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x07e5fadce9989179, "C is as portable as Stonehedge!!"},
+	{0x35a752e027ec89ea, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x4c1449963da9afbf, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xc71afccd46d62d9d, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	var parts = []string{
-		"h",
-		"ell",
-		"o",
-		"he",
-		"llo",
-		"hellohello",
-	}
+var goldenRapidHash = []_Golden64{
+	{0x93228a4de0eec5a2, ""},
+	{0xf60afd8e64f72c4b, "a"},
+	{0x1c9df56370f2a08e, "ab"},
+	{0x7270d92a69eaa3b2, "abc"},
+	{0x4c541daf42e1521a, "abcd"},
+	{0x3ae4eb3e18a4abef, "abcde"},
+	{0x9fdf10eab5ec80c4, "abcdef"},
+	{0xb0bcd552e7e08127, "abcdefg"},
+	{0x23d9574f9a8a70c0, "abcdefgh"},
+	{0x9d8943acbd3c1c13, "abcdefghi"},
+	{0xe1766644648a062b, "abcdefghij"},
+	{0x9956eac9636f5475, "Discard medicine more than two years old."},
+	{0x97e181a84d2738b2, "He who has a shady past knows that nice guys finish last."},
+	{0x6c64193183a66ca8, "I wouldn't marry him with a ten foot pole."},
+	{0x75cacbf29163d799, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xb47eeab178f8cbac, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xd7b3d1c62794bdb6, "Nepal premier won't resign."},
+	{0xc301e298722ecbf4, "For every action there is an equal and opposite government program."},
+	{0xb49832b83dbdfcee, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0xb48797bd3d958559, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x13fee2fcb76648a7, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xc0bda05b570f5444, "size:  a.out:  bad magic"},
+	{0xb53faddbdc2af9c6, "The major problem is with sendmail.  -Mark Horton"},
+	{0x6bf009543e4c6fe8, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x8be7fea4cbf7d2aa, "If the enemy is within range, then so are you."},
+	{0xa3a705850c2f7dea, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xca51fb4227d25a89, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x60b0de548d364daf, "C is as portable as Stonehedge!!"},
+	{0x0e3ae44a1c90eeab, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xf702f6ec242b83fa, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xac6ecd74fe8e18fa, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	for _, p := range parts {
-		l, _ := h.Write([]byte(p))
-		if l != len(p) {
-			t.Errorf("Write(%d bytes) = %d, want %d\n", len(p), l, len(p))
-		}
-	}
+var goldenMetroHash64_1 = []_Golden64{
+	{0xb9b61f89292d08a3, ""},
+	{0x5dcd5045ac6fd953, "a"},
+	{0x00b0bfef330173c4, "ab"},
+	{0x0da4dbbd6e8b67a5, "abc"},
+	{0x40c5192396107887, "abcd"},
+	{0xadb79402b13d4cda, "abcde"},
+	{0x6273141be342397a, "abcdef"},
+	{0x6b91a3e606793306, "abcdefg"},
+	{0x78cd712930d10344, "abcdefgh"},
+	{0xd8cfddd8e56002eb, "abcdefghi"},
+	{0xfcd8172df4e5abfd, "abcdefghij"},
+	{0xdac9b4be49e7ab0d, "Discard medicine more than two years old."},
+	{0x49e2cbfebbb928ac, "He who has a shady past knows that nice guys finish last."},
+	{0x79f8c426ccf36e86, "I wouldn't marry him with a ten foot pole."},
+	{0xe0d09c4d8cdf144b, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x0c24e9c75b7cb2fa, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xa5830947bc19572d, "Nepal premier won't resign."},
+	{0xf9201d8861a1712c, "For every action there is an equal and opposite government program."},
+	{0x35dbe3fabb25c752, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x3f6991fc1e8aac6f, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x85efbf687d4b6f11, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0xcfd91a3ec013caf3, "size:  a.out:  bad magic"},
+	{0x985b6e90b2745b2b, "The major problem is with sendmail.  -Mark Horton"},
+	{0x6ae7d166e8d7affa, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x335ceb741ceed98b, "If the enemy is within range, then so are you."},
+	{0xab6a2ec6587442a5, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x4255540ce5714777, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x4de0970e5478218d, "C is as portable as Stonehedge!!"},
+	{0xd9d0ea1b3a99747a, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x2edd053234959f21, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x018ee6937d7c3e63, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	h32 := h.Sum32()
+var goldenMetroHash64_2 = []_Golden64{
+	{0x705fb008071e967d, ""},
+	{0xea48521a55fc7078, "a"},
+	{0xb0ed3266c398fb7b, "ab"},
+	{0xfc3bcbd560cf75cf, "abc"},
+	{0xf8234ccc6b62dffc, "abcd"},
+	{0x5c17600076c5e42f, "abcde"},
+	{0xf9f3eda667a8c78f, "abcdef"},
+	{0x688b8391e3c63ec7, "abcdefg"},
+	{0xc63838a419554b95, "abcdefgh"},
+	{0x9db3c53b64954d74, "abcdefghi"},
+	{0x0533bec3e9d45411, "abcdefghij"},
+	{0x845401d150c45459, "Discard medicine more than two years old."},
+	{0x59d422d1a78dab9a, "He who has a shady past knows that nice guys finish last."},
+	{0x8af223b1590c34d7, "I wouldn't marry him with a ten foot pole."},
+	{0xda0f837708f830a3, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xa2f9b0765b47a3af, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xbbdf9f24da18ab52, "Nepal premier won't resign."},
+	{0xfd043a0fbbb6757b, "For every action there is an equal and opposite government program."},
+	{0x2ab379c5f3218c39, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x09d349e9f67e035b, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x5357803642d6b1b9, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x171f6c0f981e0758, "size:  a.out:  bad magic"},
+	{0xc7503dbe83722f24, "The major problem is with sendmail.  -Mark Horton"},
+	{0x8e8589ef755aa8ef, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x119d56593d1c659b, "If the enemy is within range, then so are you."},
+	{0xae2f75e62e8adaf0, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xfd793a4a98cfe3d3, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x6456a04bfd46d571, "C is as portable as Stonehedge!!"},
+	{0x7215b99693c868ed, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x5cf1fef6a150afba, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x09260866a46109ac, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	if h32 != result {
-		t.Errorf("%s: incremental failed: got %08x", which, h32)
-	}
+var goldenMetroHash128_1 = []_Golden128{
+	{0x0005f3ca3d41d1cb, 0x4606b14684c65fb6, ""},
+	{0x1df3cf35855cb399, 0xaf454ebda0102ce2, "a"},
+	{0x912920a5e2c944c4, 0xbe8ddfb0b6efa737, "ab"},
+	{0x18e24c609c90316b, 0x048a81dbe73142ae, "abc"},
+	{0xe04c8de7f5d06bd4, 0x5de038c4fe85a58a, "abcd"},
+	{0x0a73aace4c995ac2, 0x314daf97d81993d8, "abcde"},
+	{0x86e478462d4449d9, 0x244f8d02d4bc7521, "abcdef"},
+	{0x88d37f08e2a1426b, 0x15f1fe6ab885a0b4, "abcdefg"},
+	{0xe4b50d335af3875a, 0xe85814eff5d4195b, "abcdefgh"},
+	{0x55c6d163677fcbe6, 0x7bcc02e185d24fd1, "abcdefghi"},
+	{0x63e1023312f3e202, 0xc877989333aa2881, "abcdefghij"},
+	{0xec666dcdc9b42bbd, 0x506e8787193f0ccc, "Discard medicine more than two years old."},
+	{0x2f2884551446289c, 0xa3167b17b21d3009, "He who has a shady past knows that nice guys finish last."},
+	{0xb9733e1cb8cc4c19, 0xd31a2626dedd8e7a, "I wouldn't marry him with a ten foot pole."},
+	{0xfd22bfaeda22fc06, 0xd74c0b945313d627, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x60d9c6f049683f1b, 0xdb5a46534e39c639, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0xe9ab720286dfba39, 0xeef251065862d486, "Nepal premier won't resign."},
+	{0x271cde70f3fcd170, 0xb25e84c126ef6d62, "For every action there is an equal and opposite government program."},
+	{0x3299ce24dc1c5621, 0xe23477c9e5bd2e38, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x05a73494e5b51dd0, 0x4750a5e9bc5a981e, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x96e25831cc721cbb, 0x7365b9496a4ed3cd, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x903ba1fc9ff51c91, 0xc66e96ea8fb7eb90, "size:  a.out:  bad magic"},
+	{0x9a47e0378b738be9, 0x4e16327270dd0813, "The major problem is with sendmail.  -Mark Horton"},
+	{0x65a6a0d898b981b9, 0x181e05542bd1800c, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x8113c501cef757a2, 0xd30456679ac4efde, "If the enemy is within range, then so are you."},
+	{0x7f9b86736c0869c6, 0x4a171a4d46be0377, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0xbbdeb300b2cf5ac2, 0xdcafd2367dd3df13, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x7d0503e4b3dc69c2, 0xd7d6d45aa5305d54, "C is as portable as Stonehedge!!"},
+	{0x5d1c4f30b04f9602, 0x0ed45548068f6c4a, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x01b2ac61833b8b50, 0x01c68007398207be, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xc7b37e9510163312, 0x33bb74dcf8f96948, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	h.Reset()
-	h.Write([]byte("hellohellohellohello"))
+var goldenMetroHash128_2 = []_Golden128{
+	{0xb0dbaf7ac6c3129c, 0x97b7d09b58b758db, ""},
+	{0x2cb6775e9425ffcc, 0x9c1e837b12ac2b59, "a"},
+	{0xb3f7bea0a89e5a41, 0x88224d00af3887dd, "ab"},
+	{0x0b93f05b3a6aa708, 0xc127e5ec4dc2da5f, "abc"},
+	{0x5990bf8fd7b2329b, 0x8b4a5e53d064bf62, "abcd"},
+	{0x055b068cacf67ff7, 0x4a54656488239154, "abcde"},
+	{0x2f236642ea43abe2, 0x3e465c0824910b7d, "abcdef"},
+	{0x4281e2a8c8f8d3ad, 0x2cf6c2cede380495, "abcdefg"},
+	{0xbb3a9e06eb414d6b, 0xb3a18106434016df, "abcdefgh"},
+	{0x83fbc2227611dcaa, 0x19438f1aaad968cf, "abcdefghi"},
+	{0x4930a09e01a9a5f9, 0x7a80be227faa78c4, "abcdefghij"},
+	{0x5d93ec8adbb48dbe, 0x85d647931cb355b1, "Discard medicine more than two years old."},
+	{0x39f0fa7b19cd537b, 0x3ecf70544ed2def9, "He who has a shady past knows that nice guys finish last."},
+	{0xd30f8b6c5d62d5b3, 0x56b25630c4ec7a21, "I wouldn't marry him with a ten foot pole."},
+	{0x730b8b0371c29fa2, 0x6a160d15c17d3e08, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xe898c2e8c5e7f612, 0x57baed3f98248584, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x996f59eb9f6f672d, 0x47c2c9decd973c84, "Nepal premier won't resign."},
+	{0xf0a1a76b4df39aa5, 0xc5c1595ac99898f2, "For every action there is an equal and opposite government program."},
+	{0x41b2972979337780, 0x4d3203d7e5074404, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x02159003b15c0fc3, 0x44cdbb5c1d9dc08d, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xfeec4682961a4ddd, 0xbffa25288cacde7b, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x29a32b5f8ee5ed50, 0xadde326774ad4453, "size:  a.out:  bad magic"},
+	{0x513078817f7f1c23, 0x712febf01c4d0539, "The major problem is with sendmail.  -Mark Horton"},
+	{0x5c47056da144beeb, 0x5aafef9987053e01, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x05e338e92234f903, 0x49f153b6eb080f1d, "If the enemy is within range, then so are you."},
+	{0xa313367b861e0f41, 0xb8895b048830dbad, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x5cf53af7678fb15d, 0x35e7f4ae36bcf33e, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x30376dc1a6fb6157, 0x86d69f5392681f4f, "C is as portable as Stonehedge!!"},
+	{0xdef2b4410df488ee, 0x7f8ee10622ce8b8d, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0xb5a2d7cf799128e5, 0xea7a23729dcf3d52, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xe0c17d980bdda050, 0xdc8987d54b8db8d4, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	h32 = h.Sum32()
+var goldenPoly61 = []_Golden64{
+	{0x0e789e6aa1b965f8, ""},
+	{0x109946a41cd73416, "a"},
+	{0x1101385635201dae, "ab"},
+	{0x1218ac7c5fbe7a90, "abc"},
+	{0x12d9ee478dd956ec, "abcd"},
+	{0x1f47e1bce549c4b1, "abcde"},
+	{0x1daabdb245955c9e, "abcdef"},
+	{0x094a9a5fc3416feb, "abcdefg"},
+	{0x12f0413935694043, "abcdefgh"},
+	{0x0a349d06237d7e88, "abcdefghi"},
+	{0x06790d880f1f547d, "abcdefghij"},
+	{0x0ec9d44f73debe4b, "Discard medicine more than two years old."},
+	{0x058b7988101705ac, "He who has a shady past knows that nice guys finish last."},
+	{0x09cf6ed91eb7b11e, "I wouldn't marry him with a ten foot pole."},
+	{0x0555f1cc239d5931, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x1080f6038b37f642, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x095d432f39cc56aa, "Nepal premier won't resign."},
+	{0x15bee3ea5b26ed34, "For every action there is an equal and opposite government program."},
+	{0x07453d9d25a18354, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x19ce27cd7ddfed80, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x06ddbc6b5a122dba, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x0da3eb301fbf782c, "size:  a.out:  bad magic"},
+	{0x001df92edb4588c9, "The major problem is with sendmail.  -Mark Horton"},
+	{0x087ed6ddb2041244, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x02cfb3b348c5f728, "If the enemy is within range, then so are you."},
+	{0x0918e26a1d48cac9, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x18fe93cc6d64190c, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x0f7e8e550ff28503, "C is as portable as Stonehedge!!"},
+	{0x06b7de1515a0755e, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x1bdc656fea2566c5, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x077607ef5ed75e81, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	if h32 != result {
-		t.Errorf("%s: failed: got %08x", which, h32)
-	}
+var goldenFletcher16 = []_Golden{
+	{0x00000000, ""},
+	{0x00006161, "a"},
+	{0x000025c3, "ab"},
+	{0x00004c27, "abc"},
+	{0x0000d78b, "abcd"},
+	{0x0000c8f0, "abcde"},
+	{0x00002057, "abcdef"},
+	{0x0000debe, "abcdefg"},
+	{0x00000627, "abcdefgh"},
+	{0x00009690, "abcdefghi"},
+	{0x000091fa, "abcdefghij"},
+	{0x00001110, "Discard medicine more than two years old."},
+	{0x0000c98a, "He who has a shady past knows that nice guys finish last."},
+	{0x0000f6ee, "I wouldn't marry him with a ten foot pole."},
+	{0x00002727, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x00003393, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x0000adf2, "Nepal premier won't resign."},
+	{0x00008415, "For every action there is an equal and opposite government program."},
+	{0x0000c475, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x00003180, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x0000ef83, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x0000fee5, "size:  a.out:  bad magic"},
+	{0x0000dc81, "The major problem is with sendmail.  -Mark Horton"},
+	{0x0000771c, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x0000073a, "If the enemy is within range, then so are you."},
+	{0x000003fe, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x0000105d, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x0000f013, "C is as portable as Stonehedge!!"},
+	{0x0000abec, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x0000427e, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x00003728, "How can you write a big system without C++?  -Paul Glick"},
 }
 
-func testGolden(t *testing.T, h hash.Hash32, golden []_Golden, which string) {
+var goldenFletcher32 = []_Golden{
+	{0x00000000, ""},
+	{0x00610061, "a"},
+	{0x62616261, "ab"},
+	{0xc52562c4, "abc"},
+	{0x2926c6c4, "abcd"},
+	{0xf04fc729, "abcde"},
+	{0x56502d2a, "abcdef"},
+	{0x83e12d91, "abcdefg"},
+	{0xebe19591, "abcdefgh"},
+	{0x81dc95fa, "abcdefghi"},
+	{0xebdcfffa, "abcdefghij"},
+	{0xc8b4d936, "Discard medicine more than two years old."},
+	{0x6da8d6b3, "He who has a shady past knows that nice guys finish last."},
+	{0x26acae40, "I wouldn't marry him with a ten foot pole."},
+	{0x6c30ea3c, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xcd87791a, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x115f41b1, "Nepal premier won't resign."},
+	{0xb08adc38, "For every action there is an equal and opposite government program."},
+	{0x68ea6c09, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x6adf631d, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0xa54c7013, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x59cf5293, "size:  a.out:  bad magic"},
+	{0x992d3051, "The major problem is with sendmail.  -Mark Horton"},
+	{0xfd96b16a, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0xfb2f4eeb, "If the enemy is within range, then so are you."},
+	{0x6699fc02, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x7cd492ca, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xe8cb789a, "C is as portable as Stonehedge!!"},
+	{0x082ab933, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x4e6c334b, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x76362305, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-	for _, g := range golden {
-		h.Reset()
-		h.Write([]byte(g.in))
+var goldenFletcher64 = []_Golden64{
+	{0x0000000000000000, ""},
+	{0x0000006100000061, "a"},
+	{0x0000626100006261, "ab"},
+	{0x0063626100636261, "abc"},
+	{0x6463626164636261, "abcd"},
+	{0xc8c6c527646362c6, "abcde"},
+	{0xc8c72b276463c8c6, "abcdef"},
+	{0xc92e2b2764cac8c6, "abcdefg"},
+	{0x312e2b28cccac8c6, "abcdefgh"},
+	{0xfdf8f457cccac92f, "abcdefghi"},
+	{0xfdf95e57cccb332f, "abcdefghij"},
+	{0x49f58057f2ade688, "Discard medicine more than two years old."},
+	{0xc31d6ad31786bf2d, "He who has a shady past knows that nice guys finish last."},
+	{0xcde98084c7efe650, "I wouldn't marry him with a ten foot pole."},
+	{0x1a82b6404b179f25, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0xa0cd433a816df7ac, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x4610dc0a32d70eda, "Nepal premier won't resign."},
+	{0x271ec58028b3b385, "For every action there is an equal and opposite government program."},
+	{0xe44f8ccc0d455ec4, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x8ecb8568bd88a594, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x90ba1cedb602ba10, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x7c5f67656db9e4d9, "size:  a.out:  bad magic"},
+	{0x5d4b2f2c4f6fe0e1, "The major problem is with sendmail.  -Mark Horton"},
+	{0x469b93a6b6ebfa7e, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x7c0ce147708cde5e, "If the enemy is within range, then so are you."},
+	{0xfaabff3b91336acf, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x36b2e317b6bfdc0a, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0xeb454a328224f675, "C is as portable as Stonehedge!!"},
+	{0x2cb876603e077b2c, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x0191588b65cbcd7f, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0xc9bf65b9e8bd3a47, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-		sum := h.Sum32()
+var goldenBSDSum = []_Golden{
+	{0x00000000, ""},
+	{0x00000061, "a"},
+	{0x00008092, "ab"},
+	{0x000040ac, "abc"},
+	{0x000020ba, "abcd"},
+	{0x000010c2, "abcde"},
+	{0x000008c7, "abcdef"},
+	{0x000084ca, "abcdefg"},
+	{0x000042cd, "abcdefgh"},
+	{0x0000a1cf, "abcdefghi"},
+	{0x0000d151, "abcdefghij"},
+	{0x00000ec3, "Discard medicine more than two years old."},
+	{0x000092e1, "He who has a shady past knows that nice guys finish last."},
+	{0x00006fe9, "I wouldn't marry him with a ten foot pole."},
+	{0x0000cf33, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x0000d65d, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x0000254d, "Nepal premier won't resign."},
+	{0x000002d4, "For every action there is an equal and opposite government program."},
+	{0x0000eb6e, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x00009af9, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x0000490b, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x0000fb94, "size:  a.out:  bad magic"},
+	{0x000049b2, "The major problem is with sendmail.  -Mark Horton"},
+	{0x000099ba, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x0000b5db, "If the enemy is within range, then so are you."},
+	{0x00002b45, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x0000a963, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x0000d4ab, "C is as portable as Stonehedge!!"},
+	{0x0000d485, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x00009910, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x0000ea4f, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-		if sum != g.out {
-			t.Errorf("%s(%s) = 0x%x want 0x%x", which, g.in, sum, g.out)
-		}
+var goldenSysVSum = []_Golden{
+	{0x00000000, ""},
+	{0x00000061, "a"},
+	{0x000000c3, "ab"},
+	{0x00000126, "abc"},
+	{0x0000018a, "abcd"},
+	{0x000001ef, "abcde"},
+	{0x00000255, "abcdef"},
+	{0x000002bc, "abcdefg"},
+	{0x00000324, "abcdefgh"},
+	{0x0000038d, "abcdefghi"},
+	{0x000003f7, "abcdefghij"},
+	{0x00000f01, "Discard medicine more than two years old."},
+	{0x00001476, "He who has a shady past knows that nice guys finish last."},
+	{0x00000ee0, "I wouldn't marry him with a ten foot pole."},
+	{0x00001314, "Free! Free!/A trip/to Mars/for 900/empty jars/Burma Shave"},
+	{0x0000147f, "The days of the digital watch are numbered.  -Tom Stoppard"},
+	{0x000009e9, "Nepal premier won't resign."},
+	{0x000018fc, "For every action there is an equal and opposite government program."},
+	{0x00001461, "His money is twice tainted: 'taint yours and 'taint mine."},
+	{0x00001e62, "There is no reason for any individual to have a computer in their home. -Ken Olsen, 1977"},
+	{0x00001a69, "It's a tiny change to the code and not completely disgusting. - Bob Manchek"},
+	{0x000007de, "size:  a.out:  bad magic"},
+	{0x00001170, "The major problem is with sendmail.  -Mark Horton"},
+	{0x00001903, "Give me a rock, paper and scissors and I will move the world.  CCFestoon"},
+	{0x0000102a, "If the enemy is within range, then so are you."},
+	{0x000018e6, "It's well we cannot hear the screams/That we create in others' dreams."},
+	{0x00001746, "You remind me of a TV show, but that's all right: I watch it anyway."},
+	{0x00000b08, "C is as portable as Stonehedge!!"},
+	{0x00001dcf, "Even if I could be Shakespeare, I think I should still choose to be Faraday. - A. Huxley"},
+	{0x0000304e, "The fugacity of a constituent in a mixture of gases at a given temperature is proportional to its mole fraction.  Lewis-Randall Rule"},
+	{0x00001315, "How can you write a big system without C++?  -Paul Glick"},
+}
 
-		bsum := h.Sum(nil)
+func TestJava(t *testing.T) {
+	testGolden(t, NewJava32(), goldenJava, "java")
+}
 
-		if len(bsum) != 4 {
-			t.Errorf("%s Sum(nil) returned %d bytes, wanted 4: %s\n", which, len(bsum), bsum)
-		}
+func TestJava64(t *testing.T) {
+	testGolden64(t, NewJava64(), goldenJava64, "java64")
+}
 
-		s := binary.BigEndian.Uint32(bsum)
+func TestDbj32(t *testing.T) {
+	testGolden(t, NewDjb32(), goldenDjb32, "djb")
+}
 
-		if s != sum {
-			t.Errorf("%s(%s).Sum(nil) = 0x%x want 0x%x", which, g.in, sum, g.out)
-		}
+func TestDbj64(t *testing.T) {
+	testGolden64(t, NewDjb64(), goldenDjb64, "djb64")
+}
 
-		bsum = h.Sum([]byte{0x01, 0x02, 0x03, 0x04})
+func TestDbj32a(t *testing.T) {
+	testGolden(t, NewDjb32a(), goldenDjb32a, "djb2a")
+}
 
-		if len(bsum) != 8 {
-			t.Errorf("%s Sum(bsum) returned %d bytes, wanted 8: %x\n", which, len(bsum), bsum)
-		}
+func TestDbj64a(t *testing.T) {
+	testGolden64(t, NewDjb64a(), goldenDjb64a, "djb64a")
+}
 
-		s = binary.BigEndian.Uint32(bsum[0:])
-		s2 := binary.BigEndian.Uint32(bsum[4:])
+func TestElf32(t *testing.T) {
+	testGolden(t, NewElf32(), goldenElf32, "elf32")
+}
+
+func TestSDBM(t *testing.T) {
+	testGolden(t, NewSDBM32(), goldenSdbm, "sdbm")
+}
+
+func TestSDBM64(t *testing.T) {
+	testGolden64(t, NewSDBM64(), goldenSdbm64, "sdbm64")
+}
+
+func TestSqlite3(t *testing.T) {
+	testGolden(t, NewSQLite32(), goldenSqlite, "sqlite3")
+}
+
+func TestJenkins(t *testing.T) {
+	testGolden(t, NewJenkins32(), goldenJenkins, "jenkins")
+}
+
+func TestMurmur(t *testing.T) {
+
+	// test the incremental hashing logic
+	m := NewMurmur3_x86_32()
+
+	testIncremental(t, m, 0xe0c9df28, "murmur3")
+
+	testGolden(t, m, goldenMurmur3, "murmur3")
+
+	m = NewMurmur3_x86_32Seed(0x9747b28c)
+
+	testIncremental(t, m, 0x9d153f32, "murmur3 seeded")
+
+	// murmur's own verification test
+	v := smhasherVerification(func(key []byte, seed uint32) []byte {
+		h := NewMurmur3_x86_32Seed(seed)
+		h.Write(key)
+		return le32(h.Sum32())
+	})
+
+	if v != 0xb0f57ee3 {
+		t.Errorf("murmur3: verification failed: got %08x", v)
+	}
+}
+
+func TestMurmur3_x86_128(t *testing.T) {
+
+	m := NewMurmur3_x86_128()
+
+	testIncremental128(t, m, 0x7a8858792ab81c3b, 0x012cbaf82fb6bfef, "murmur3_x86_128")
+
+	testGolden128(t, m, goldenMurmur3_x86_128, "murmur3_x86_128")
+
+	m = NewMurmur3_x86_128Seed(0x9747b28c)
+
+	testIncremental128(t, m, 0x93281b21cf0427d0, 0x8a275a2e294ea1ef, "murmur3_x86_128 seeded")
+
+	v := smhasherVerification(func(key []byte, seed uint32) []byte {
+		h := NewMurmur3_x86_128Seed(seed)
+		h.Write(key)
+		h1, h2 := h.Sum128()
+		return append(le64(h1), le64(h2)...)
+	})
+
+	if v != 0xb3ece62a {
+		t.Errorf("murmur3_x86_128: verification failed: got %08x", v)
+	}
+}
+
+func TestMurmur3_x64_128(t *testing.T) {
+
+	m := NewMurmur3_x64_128()
+
+	testIncremental128(t, m, 0xe16c69548bff874c, 0x7cb3b6cb361fe2b2, "murmur3_x64_128")
+
+	testGolden128(t, m, goldenMurmur3_x64_128, "murmur3_x64_128")
+
+	m = NewMurmur3_x64_128Seed(0x9747b28c)
+
+	testIncremental128(t, m, 0x1d6ac004118de1e4, 0xfecd7f35979e1412, "murmur3_x64_128 seeded")
+
+	v := smhasherVerification(func(key []byte, seed uint32) []byte {
+		h := NewMurmur3_x64_128Seed(seed)
+		h.Write(key)
+		h1, h2 := h.Sum128()
+		return append(le64(h1), le64(h2)...)
+	})
+
+	if v != 0x6384ba69 {
+		t.Errorf("murmur3_x64_128: verification failed: got %08x", v)
+	}
+}
+
+func TestSuperFastHash(t *testing.T) {
+
+	// test the incremental hashing logic
+	m := NewSuperFastHash()
+
+	testIncremental(t, m, 0x54de96ed, "superfast")
+
+	testGolden(t, m, goldenSuperfast, "superfast")
+
+	for _, g := range goldenSuperFastHash {
+		if h := SuperFastHash([]byte(g.in)); h != g.out {
+			t.Errorf("SuperFastHash(%s) = 0x%x want 0x%x", g.in, h, g.out)
+		}
+
+		m := NewSuperFastHashLength(len(g.in))
+		for i := 0; i < len(g.in); i++ {
+			m.Write([]byte{g.in[i]})
+		}
+		if h := m.Sum32(); h != g.out {
+			t.Errorf("superfast length(%s) = 0x%x want 0x%x", g.in, h, g.out)
+		}
+	}
+
+	testIncremental(t, NewSuperFastHashLength(20), 0xf0323ae8, "superfast length")
+
+	// the reference code reads the tail bytes as signed chars
+	var tails = []_Golden{
+		{0xf30533c4, "\x80"},
+		{0x00000000, "\xff"},
+		{0xc25f0954, "ab\xff"},
+		{0x40b8866d, "\xfe\xfd\xfc"},
+		{0x0397fb3f, "abcd\x80"},
+		{0xf93e9954, "abcdef\xff"},
+		{0x0cc62be8, "\xff\xff\xff\xff\xff\xff\xff"},
+	}
+
+	for _, g := range tails {
+		if h := SuperFastHash([]byte(g.in)); h != g.out {
+			t.Errorf("SuperFastHash(%x) = 0x%x want 0x%x", g.in, h, g.out)
+		}
+	}
+}
+
+func TestMarvin(t *testing.T) {
+
+	m := NewMarvin32(0x5D70D359C498B3F8) // seed for testing
+
+	// test the incremental hashing logic
+	testIncremental(t, m, 0x28685e7a, "marvin")
+
+	testGolden(t, m, goldenMarvin, "marvin")
+
+	m64 := NewMarvin64(0x5D70D359C498B3F8)
+
+	testIncremental64(t, m64, 0x73a079995bc827e3, "marvin64")
+
+	testGolden64(t, m64, goldenMarvin64, "marvin64")
+
+	// vectors from the .NET runtime's Marvin tests
+	var vectors = []struct {
+		in  string
+		out uint64
+	}{
+		{"", 0x30ed35c100cd3c7d},
+		{"\xaf", 0x48e73fc77d75ddc1},
+		{"\xe7\x0f", 0xb5f6e1fc485dbff8},
+	}
+
+	m64 = NewMarvin64(0x004fb61a001bdbcc)
+	for _, v := range vectors {
+		m64.Reset()
+		m64.Write([]byte(v.in))
+		if h := m64.Sum64(); h != v.out {
+			t.Errorf("marvin64(%x) = 0x%016x want 0x%016x", v.in, h, v.out)
+		}
+	}
+}
+
+func TestMarvin32String(t *testing.T) {
+
+	var tests = []struct {
+		in  string
+		out uint32
+	}{
+		{"", 0xf7f2c954},
+		{"a", 0x957475e3},
+		{"hello", 0x0cb8c4df},
+		{"h\u00e9llo", 0x29f37562},
+		{"\u65e5\u672c\u8a9e", 0xf8412e6e},
+		{"\U0001f600", 0x8db86850}, // encoded as a surrogate pair
+		{"\xff", 0xd7cf57b0},       // invalid UTF-8 is hashed as U+FFFD
+		{"Discard medicine more than two years old.", 0x29532ca7},
+	}
+
+	m := NewMarvin32(0x5D70D359C498B3F8)
+
+	for _, tt := range tests {
+		if h := Marvin32String(0x5D70D359C498B3F8, tt.in); h != tt.out {
+			t.Errorf("Marvin32String(%q) = 0x%08x want 0x%08x", tt.in, h, tt.out)
+		}
+
+		// the same as writing the UTF-16LE bytes ourselves
+		m.Reset()
+		for _, r := range utf16.Encode([]rune(tt.in)) {
+			m.Write([]byte{byte(r), byte(r >> 8)})
+		}
+		if h := m.Sum32(); h != tt.out {
+			t.Errorf("marvin(utf16(%q)) = 0x%08x want 0x%08x", tt.in, h, tt.out)
+		}
+	}
+}
+
+func TestMurmur2(t *testing.T) {
+
+	m := NewMurmur2(0)
+
+	testIncremental(t, m, 0xdf79c723, "murmur2")
+
+	testGolden(t, m, goldenMurmur2, "murmur2")
+
+	testGolden(t, NewMurmurNeutral2(0), goldenMurmur2, "murmur2 neutral")
+
+	testGolden(t, NewMurmurAligned2(0), goldenMurmur2, "murmur2 aligned")
+
+	testIncremental(t, NewMurmur2(0x9747b28c), 0x9c7f1348, "murmur2 seeded")
+
+	v := smhasherVerification(func(key []byte, seed uint32) []byte {
+		h := NewMurmur2(seed)
+		h.Write(key)
+		return le32(h.Sum32())
+	})
+
+	if v != 0x27864c1e {
+		t.Errorf("murmur2: verification failed: got %08x", v)
+	}
+}
+
+func TestMurmur2A(t *testing.T) {
+
+	m := NewMurmur2A(0)
+
+	testIncremental(t, m, 0xa5bf1412, "murmur2a")
+
+	testGolden(t, m, goldenMurmur2A, "murmur2a")
+
+	testIncremental(t, NewMurmur2A(0x9747b28c), 0xcf82d09b, "murmur2a seeded")
+
+	v := smhasherVerification(func(key []byte, seed uint32) []byte {
+		h := NewMurmur2A(seed)
+		h.Write(key)
+		return le32(h.Sum32())
+	})
+
+	if v != 0x7fbd4396 {
+		t.Errorf("murmur2a: verification failed: got %08x", v)
+	}
+}
+
+func TestMurmur64A(t *testing.T) {
+
+	m := NewMurmur64A(0)
+
+	testIncremental64(t, m, 0x1dbe570e4f7f5273, "murmur64a")
+
+	testGolden64(t, m, goldenMurmur64A, "murmur64a")
+
+	testIncremental64(t, NewMurmur64A(0x9747b28c), 0x976c7136e1d4d068, "murmur64a seeded")
+
+	v := smhasherVerification(func(key []byte, seed uint32) []byte {
+		h := NewMurmur64A(uint64(seed))
+		h.Write(key)
+		return le64(h.Sum64())
+	})
+
+	if v != 0x1f0d3804 {
+		t.Errorf("murmur64a: verification failed: got %08x", v)
+	}
+}
+
+func TestMurmur64B(t *testing.T) {
+
+	m := NewMurmur64B(0)
+
+	testIncremental64(t, m, 0x6952d72e8654b40d, "murmur64b")
+
+	testGolden64(t, m, goldenMurmur64B, "murmur64b")
+
+	testIncremental64(t, NewMurmur64B(0x9747b28c), 0x2771a5958e28e668, "murmur64b seeded")
+
+	v := smhasherVerification(func(key []byte, seed uint32) []byte {
+		h := NewMurmur64B(uint64(seed))
+		h.Write(key)
+		return le64(h.Sum64())
+	})
+
+	if v != 0xdd537c05 {
+		t.Errorf("murmur64b: verification failed: got %08x", v)
+	}
+}
+
+func TestXXH32(t *testing.T) {
+
+	m := NewXXH32(0)
+
+	testIncremental(t, m, 0xbfa38653, "xxh32")
+
+	testGolden(t, m, goldenXXH32, "xxh32")
+}
+
+func TestXXH64(t *testing.T) {
+
+	m := NewXXH64(0)
+
+	testIncremental64(t, m, 0x468db6eb3e22ccf5, "xxh64")
+
+	testGolden64(t, m, goldenXXH64, "xxh64")
+}
+
+// the sanity checks from xxhsum
+func TestXXHSanity(t *testing.T) {
+
+	const prime32 = 2654435761
+
+	var tests = []struct {
+		length int
+		seed   uint32
+		h32    uint32
+		h64    uint64
+	}{
+		{0, 0, 0x02cc5d05, 0xef46db3751d8e999},
+		{0, prime32, 0x36b78ae7, 0xac75fda2929b17ef},
+		{1, 0, 0xcf65b03e, 0xe934a84adb052768},
+		{1, prime32, 0xb4545aa4, 0x5014607643a9b4c3},
+		{14, 0, 0x1208e7e2, 0x8282dcc4994e35c8},
+		{14, prime32, 0x6af1d1fe, 0xc3bd6bf63deb6df0},
+		{222, 0, 0x5bd11dbd, 0xb641ae8cb691c174},
+		{222, prime32, 0x58803c5f, 0x20cb8ab7ae10c14a},
+	}
+
+	buf := xxhSanityBuffer(222)
+
+	for _, tt := range tests {
+
+		h32 := NewXXH32(tt.seed)
+		h64 := NewXXH64(uint64(tt.seed))
+
+		h32.Write(buf[:tt.length])
+		h64.Write(buf[:tt.length])
+
+		if s := h32.Sum32(); s != tt.h32 {
+			t.Errorf("xxh32(%d bytes, seed=%d) = 0x%08x want 0x%08x", tt.length, tt.seed, s, tt.h32)
+		}
+
+		if s := h64.Sum64(); s != tt.h64 {
+			t.Errorf("xxh64(%d bytes, seed=%d) = 0x%016x want 0x%016x", tt.length, tt.seed, s, tt.h64)
+		}
+
+		// and again one byte at a time to exercise the stripe buffering
+		h32.Reset()
+		h64.Reset()
+
+		for i := 0; i < tt.length; i++ {
+			h32.Write(buf[i : i+1])
+			h64.Write(buf[i : i+1])
+		}
+
+		if s := h32.Sum32(); s != tt.h32 {
+			t.Errorf("xxh32(%d bytes, seed=%d) incremental = 0x%08x want 0x%08x", tt.length, tt.seed, s, tt.h32)
+		}
+
+		if s := h64.Sum64(); s != tt.h64 {
+			t.Errorf("xxh64(%d bytes, seed=%d) incremental = 0x%016x want 0x%016x", tt.length, tt.seed, s, tt.h64)
+		}
+	}
+}
+
+func TestXXH3_64(t *testing.T) {
+
+	m := NewXXH3_64()
+
+	testIncremental64(t, m, 0xdc242d4513a22117, "xxh3_64")
+
+	testGolden64(t, m, goldenXXH3_64, "xxh3_64")
+
+	for _, g := range goldenXXH3_64 {
+		if h := XXH3_64([]byte(g.in)); h != g.out {
+			t.Errorf("XXH3_64(%s) = 0x%x want 0x%x", g.in, h, g.out)
+		}
+	}
+}
+
+func TestXXH3_128(t *testing.T) {
+
+	m := NewXXH3_128()
+
+	testIncremental128(t, m, 0xe068e76deae196e6, 0xf5cfa15b1b139b8b, "xxh3_128")
+
+	testGolden128(t, m, goldenXXH3_128, "xxh3_128")
+
+	for _, g := range goldenXXH3_128 {
+		if h1, h2 := XXH3_128([]byte(g.in)); h1 != g.h1 || h2 != g.h2 {
+			t.Errorf("XXH3_128(%s) = 0x%016x 0x%016x want 0x%016x 0x%016x", g.in, h1, h2, g.h1, g.h2)
+		}
+	}
+}
+
+// XXH3 takes different code paths depending on the length of the input, so
+// check a range of lengths with the default secret, a seed, and a custom secret.
+func TestXXH3Sanity(t *testing.T) {
+
+	const prime64 = 11400714785074694797
+
+	var tests = []struct {
+		length int
+		h64    [3]uint64
+		h128   [3][2]uint64
+	}{
+		{0, [3]uint64{0x2d06800538d394c2, 0xa8a6b918b2f0364a, 0x3559d64878c5c66c}, [3][2]uint64{{0x99aa06d3014798d8, 0x6001c324468d497f}, {0x00feaa732a3ce25e, 0xa986dfc5d7605bfe}, {0x5f70f4ea232f1d38, 0x005923cceecbe8ae}}},
+		{1, [3]uint64{0xc44bdff4074eecdb, 0x032be332dd766ef8, 0x8a52451418b2da4d}, [3][2]uint64{{0xa6cd5e9392000f6a, 0xc44bdff4074eecdb}, {0x20e49abcc53b3842, 0x032be332dd766ef8}, {0x3a66af5a9819198e, 0x8a52451418b2da4d}}},
+		{6, [3]uint64{0x27b56a84cd2d7325, 0x84589c116ab59ab9, 0x82c90ab0519369ad}, [3][2]uint64{{0x082afe0b8162d12a, 0x3e7039bdda43cfc6}, {0x014bd95a51ca5ddb, 0xc5b54d56038e4e40}, {0x376bd91b6432f36d, 0x0b61c8aca7d4778f}}},
+		{12, [3]uint64{0xa713daf0dfbb77e7, 0xe7303e1b2336de0e, 0x14631e773b78ec57}, [3][2]uint64{{0x6e3efd8fc7802b18, 0x061a192713f69ad9}, {0xff0d60acd02ed401, 0x5d92b5d7190b12d1}, {0x90a3c2d839f57d0f, 0xaf82f6eba263d7d8}}},
+		{24, [3]uint64{0xa3fe70bf9d3510eb, 0x850e80fc35bdd690, 0xcdd5542e4a9d9fe8}, [3][2]uint64{{0x0ce966e4678d3761, 0x1e7044d28b1b901d}, {0xd7895ded1f62559d, 0xc6cbf92a70680b19}, {0x3476c01ab8b8e821, 0xd9ed8351e0bb5526}}},
+		{48, [3]uint64{0x397da259ecba1f11, 0xadc2cbaa44acc616, 0x33abd54d094b2534}, [3][2]uint64{{0xa002ac4e5478227e, 0xf942219aed80f67b}, {0xbc689f4c0152fb44, 0x3a94d91333ed395a}, {0x2c599633a4d78138, 0xd3488d14a0fc9147}}},
+		{80, [3]uint64{0xbcdefbbb2c47c90a, 0xc6dd0cb699532e73, 0xe687ba1684965297}, [3][2]uint64{{0xfdf2cefde9eaac8a, 0x454ae6bf7a8a532d}, {0x19bf02d69bc56833, 0xa5eac764d1ff1166}, {0x5de1c8eb7bd056b8, 0x12568d8d732f8544}}},
+		{195, [3]uint64{0xcd94217ee362ec3a, 0xba68003d370cb3d9, 0xa057273f5eecfb20}, [3][2]uint64{{0x7729543a26b207ee, 0x3fb593c086a66075}, {0x0326104c4d4849e7, 0xcf9d9ec2c8c9913f}, {0x18783075f43015be, 0x002cdb4476b9a36f}}},
+		{403, [3]uint64{0xcdeb804d65c6dea4, 0x6259f6ecfd6443fd, 0x14546019124d43b8}, [3][2]uint64{{0x1b6de21e332dd73d, 0xcdeb804d65c6dea4}, {0xbed311971e0be8f2, 0x6259f6ecfd6443fd}, {0xe14eedf084a487f3, 0x14546019124d43b8}}},
+		{512, [3]uint64{0x617e49599013cb6b, 0x3ce457de14c27708, 0x7564693dd526e28d}, [3][2]uint64{{0x18d2d110dcc9bca1, 0x617e49599013cb6b}, {0x925d06b8ec5b8040, 0x3ce457de14c27708}, {0x918c0f2c7656ab6d, 0x7564693dd526e28d}}},
+		{2048, [3]uint64{0xdd59e2c3a5f038e0, 0x66f81670669ababc, 0xd32e975821d6519f}, [3][2]uint64{{0xf736557fd47073a5, 0xdd59e2c3a5f038e0}, {0x23cc3a2e75ebaaea, 0x66f81670669ababc}, {0xe862d841c07049af, 0xd32e975821d6519f}}},
+		{2099, [3]uint64{0xc6b9d9b3fc9ac765, 0x184f316843663974, 0x8dcd6d2487f34e24}, [3][2]uint64{{0xad48ae0a0951dc52, 0xc6b9d9b3fc9ac765}, {0xef4c13b1d2fdad6e, 0x184f316843663974}, {0x6a997419b3faeed7, 0x8dcd6d2487f34e24}}},
+		{2240, [3]uint64{0x6e73a90539cf2948, 0x757ba8487d1b5247, 0xb26c938c7af3a71f}, [3][2]uint64{{0xccb134fbfa7ce49d, 0x6e73a90539cf2948}, {0xe40842f585875ba9, 0x757ba8487d1b5247}, {0x1e89ee710a768055, 0xb26c938c7af3a71f}}},
+		{2367, [3]uint64{0xcb37aeb9e5d361ed, 0xd2db3415b942b42a, 0x293fa8e5173bb5e7}, [3][2]uint64{{0xe89c0f6ff369b427, 0xcb37aeb9e5d361ed}, {0xccb7a94cca1a6496, 0xd2db3415b942b42a}, {0x343654a35acf0dae, 0x293fa8e5173bb5e7}}},
+		{4160, [3]uint64{0x4f323b15321e94e1, 0x1bf6f5faf9eecabd, 0x7cd56bf8cb910475}, [3][2]uint64{{0x67140711c1e3e335, 0x4f323b15321e94e1}, {0xee430626ba3b2f3c, 0x1bf6f5faf9eecabd}, {0x06cd51765e2d12b6, 0x7cd56bf8cb910475}}},
+	}
+
+	buf := xxhSanityBuffer(4096 + 64 + 1)
+	secret := buf[7 : 7+XXH3SecretSizeMin+11]
+
+	for _, tt := range tests {
+
+		data := buf[:tt.length]
+
+		h64 := [3]uint64{XXH3_64Seed(data, 0), XXH3_64Seed(data, prime64), XXH3_64Secret(data, secret)}
+
+		var h128 [3][2]uint64
+		h128[0][0], h128[0][1] = XXH3_128Seed(data, 0)
+		h128[1][0], h128[1][1] = XXH3_128Seed(data, prime64)
+		h128[2][0], h128[2][1] = XXH3_128Secret(data, secret)
+
+		if h64 != tt.h64 || h128 != tt.h128 {
+			t.Errorf("xxh3(%d bytes) = %x %x want %x %x", tt.length, h64, h128, tt.h64, tt.h128)
+		}
+
+		// and again streaming, in odd-sized pieces to exercise the buffering
+		s64 := []hash.Hash64{NewXXH3_64Seed(0), NewXXH3_64Seed(prime64), NewXXH3_64Secret(secret)}
+		s128 := []Hash128{NewXXH3_128Seed(0), NewXXH3_128Seed(prime64), NewXXH3_128Secret(secret)}
+
+		for i := range s64 {
+
+			for p := data; len(p) > 0; {
+				n := 7 + 97*i
+				if n > len(p) {
+					n = len(p)
+				}
+				s64[i].Write(p[:n])
+				s128[i].Write(p[:n])
+				p = p[n:]
+			}
+
+			h64[i] = s64[i].Sum64()
+			h128[i][0], h128[i][1] = s128[i].Sum128()
+		}
+
+		if h64 != tt.h64 || h128 != tt.h128 {
+			t.Errorf("xxh3(%d bytes) streaming = %x %x want %x %x", tt.length, h64, h128, tt.h64, tt.h128)
+		}
+	}
+}
+
+// the SipHash key used for all the tests, 00 01 02 ... 0f
+const sipK0, sipK1 = 0x0706050403020100, 0x0f0e0d0c0b0a0908
+
+func TestSipHash24(t *testing.T) {
+
+	m := NewSipHash24(sipK0, sipK1)
+
+	testIncremental64(t, m, 0x4669b37bd66ac07b, "siphash24")
+
+	testGolden64(t, m, goldenSipHash24, "siphash24")
+}
+
+func TestSipHash13(t *testing.T) {
+
+	m := NewSipHash13(sipK0, sipK1)
+
+	testIncremental64(t, m, 0x54fd7cbde54975b4, "siphash13")
+
+	testGolden64(t, m, goldenSipHash13, "siphash13")
+}
+
+func TestSipHash24_128(t *testing.T) {
+
+	m := NewSipHash24_128(sipK0, sipK1)
+
+	testIncremental128(t, m, 0x3215023dda8d49bb, 0x2af165321db9b3dd, "siphash24_128")
+
+	testGolden128(t, m, goldenSipHash24_128, "siphash24_128")
+}
+
+func TestSipHash13_128(t *testing.T) {
+
+	m := NewSipHash13_128(sipK0, sipK1)
+
+	testIncremental128(t, m, 0x926027fecb261bbf, 0x3d57d9d6a0c34213, "siphash13_128")
+
+	testGolden128(t, m, goldenSipHash13_128, "siphash13_128")
+}
+
+// the test vectors from the reference implementation: the key is 00 01 02 ... 0f, and the
+// input for vector i is the i bytes 00 01 02 ... i-1
+func TestSipHashVectors(t *testing.T) {
+
+	vec64 := []uint64{
+		0x726fdb47dd0e0e31,
+		0x74f839c593dc67fd,
+		0x0d6c8009d9a94f5a,
+		0x85676696d7fb7e2d,
+		0xcf2794e0277187b7,
+		0x18765564cd99a68d,
+		0xcbc9466e58fee3ce,
+		0xab0200f58b01d137,
+		0x93f5f5799a932462,
+		0x9e0082df0ba9e4b0,
+		0x7a5dbbc594ddb9f3,
+		0xf4b32f46226bada7,
+		0x751e8fbc860ee5fb,
+		0x14ea5627c0843d90,
+		0xf723ca908e7af2ee,
+		0xa129ca6149be45e5,
+		0x3f2acc7f57c29bdb,
+		0x699ae9f52cbe4794,
+		0x4bc1b3f0968dd39c,
+		0xbb6dc91da77961bd,
+		0xbed65cf21aa2ee98,
+		0xd0f2cbb02e3b67c7,
+		0x93536795e3a33e88,
+		0xa80c038ccd5ccec8,
+		0xb8ad50c6f649af94,
+		0xbce192de8a85b8ea,
+		0x17d835b85bbb15f3,
+		0x2f2e6163076bcfad,
+		0xde4daaaca71dc9a5,
+		0xa6a2506687956571,
+		0xad87a3535c49ef28,
+		0x32d892fad841c342,
+		0x7127512f72f27cce,
+		0xa7f32346f95978e3,
+		0x12e0b01abb051238,
+		0x15e034d40fa197ae,
+		0x314dffbe0815a3b4,
+		0x027990f029623981,
+		0xcadcd4e59ef40c4d,
+		0x9abfd8766a33735c,
+		0x0e3ea96b5304a7d0,
+		0xad0c42d6fc585992,
+		0x187306c89bc215a9,
+		0xd4a60abcf3792b95,
+		0xf935451de4f21df2,
+		0xa9538f0419755787,
+		0xdb9acddff56ca510,
+		0xd06c98cd5c0975eb,
+		0xe612a3cb9ecba951,
+		0xc766e62cfcadaf96,
+		0xee64435a9752fe72,
+		0xa192d576b245165a,
+		0x0a8787bf8ecb74b2,
+		0x81b3e73d20b49b6f,
+		0x7fa8220ba3b2ecea,
+		0x245731c13ca42499,
+		0xb78dbfaf3a8d83bd,
+		0xea1ad565322a1a0b,
+		0x60e61c23a3795013,
+		0x6606d7e446282b93,
+		0x6ca4ecb15c5f91e1,
+		0x9f626da15c9625f3,
+		0xe51b38608ef25f57,
+		0x958a324ceb064572,
+	}
+
+	vec128 := [][2]uint64{
+		{0xe6a825ba047f81a3, 0x930255c71472f66d},
+		{0x44af996bd8c187da, 0x45fc229b11597634},
+		{0xc75da4a48d227781, 0xe4ff0af6de8ba3fc},
+		{0x4ea967520cb6709c, 0x51ed8529b0b6335f},
+		{0xaf8f9c2dc16481f8, 0x7955cd7b7c6e0f7d},
+		{0x886f778059876813, 0x27960e69077a5254},
+		{0x1386208b33caee14, 0x5ea1d78f30a05e48},
+		{0x53c1dbd8beebf1a1, 0x3982f01fa64ab8c0},
+		{0x61f55862baa9623b, 0xb49714f364e2830f},
+		{0xabbad90a06994426, 0xed716dbb028b7fc4},
+		{0x56691478c30d1100, 0xbafbd0f3d34754c9},
+		{0x77666b3868c55101, 0x18dce5816fdcb4a2},
+		{0x58f35e9066b226d6, 0x25c13285f64d6382},
+		{0x108bc0e947e26998, 0xf752b9c44f9329d0},
+		{0x9cded766aceffc31, 0x024949e45f48c77e},
+		{0x11a8b03399e99354, 0xd9c3cf970fec087e},
+		{0xbb54b067caa4e26e, 0x77052385bf1533fd},
+		{0x98b88d73e8063d47, 0x4077e47ac466c054},
+		{0x8548bf23e4e526a4, 0x23f7aefe81a44d29},
+		{0xb0fa65cf31770178, 0xb12e51528920d574},
+		{0x7390223f83fc259e, 0xeb3938e8a544933e},
+		{0x215a52be5a498e56, 0x121d073ecd14228a},
+		{0x9a6bd15245b5294a, 0xae0aff8e52109c46},
+		{0xe0f5a9d5dd84d1c9, 0x1c69bf9a9ae28ccf},
+		{0xd850bd78ae79b42d, 0xad32618a178a2a88},
+		{0x7b445e2d045fce8e, 0x6f8f8dcbeab95150},
+		{0xe807c3b3b4530b9c, 0x661f147886e0ae7e},
+		{0xe4eaa669af48f2ab, 0x94eb9e122febd3bf},
+		{0x884b576816da6406, 0xf4ae587302f335b9},
+		{0xe97d33bfc49d4baa, 0xb76a7c463cfdd40c},
+		{0xde6baf1f477f5cea, 0x87226d68d4d71a2b},
+		{0xfcfa233218b03929, 0x353dc4524fde2317},
+		{0x3efcea5eca56397c, 0x68eb4665559d3e36},
+		{0x321cf0467107c677, 0xcfffa94e5f9db6b6},
+		{0xdf7e84b86c98a637, 0xde549b30f1f02509},
+		{0xf9a8a99de6f005a7, 0xc88c3c922e1a2407},
+		{0x4648c4291f7dc43d, 0x11674f90ed769e1e},
+		{0x1a0efce601bf620d, 0x2b69d3c551473c0d},
+		{0x9e667cca8b46038c, 0xb5e7be4b085efde4},
+		{0x9c2caf3bb95b8a52, 0xd92bd2d0e5cc7344},
+		{0xad5dc9951e306adf, 0xd83b91c6c80cae97},
+		{0x397f852c90891180, 0xdbb6705e289135e7},
+		{0xbb31c2c96a3417e6, 0x5b0ccacc34ae5036},
+		{0xaa21b7ef3734d927, 0x89df5aecdc211840},
+		{0x785e9ced9d7d2389, 0x4273cc66b1c9b1d8},
+		{0x657d5ebf91806d4a, 0x4cb150a294fa8911},
+		{0x89aee75560f9330e, 0x022949cf3d0efc3f},
+		{0xd1190b722b431ce6, 0x1b1563dc4bd8c88e},
+		{0xcf82f749f5aee5f7, 0x169b2608a6559037},
+		{0x4fa5b7d00f038d43, 0x03641a20adf237a8},
+		{0xe304bf4feed390a5, 0x3f4286f2270d7e24},
+		{0xc493fe72a1c1e25f, 0x38f5f9ae7cd35cb1},
+		{0x6eb306bd5c32972c, 0x7c013a8bd03d13b2},
+		{0x94ca6b7a2214c892, 0x9ed32a009f65f09f},
+		{0x8c32d80b1150e8dc, 0x871d91d64108d5fb},
+		{0x1279dac78449f167, 0xda832592b52be348},
+		{0xe94ed572cff23819, 0x362a1da96f16947e},
+		{0xfe49ed46961e4874, 0x8e6904163024620f},
+		{0xd8d6a998dea5fc57, 0x1d8a3d58d0386400},
+		{0xbe1cdcef1cdeec9f, 0x595357d9743676d4},
+		{0x53f128eb000c04e3, 0x40e772d8cb73ca66},
+		{0xfe1d836a9a009776, 0x7a0f6793591ca9cc},
+		{0xa067f52123545358, 0xbd5947f0a447d505},
+		{0x4a83502f77d15051, 0x7cbd3f979a063e50},
+	}
+
+	in := make([]byte, len(vec64))
+
+	for i := range in {
+		in[i] = byte(i)
+	}
+
+	m := NewSipHash24(sipK0, sipK1)
+	m128 := NewSipHash24_128(sipK0, sipK1)
+
+	for i := range vec64 {
+		m.Reset()
+		m.Write(in[:i])
+		if h := m.Sum64(); h != vec64[i] {
+			t.Errorf("siphash24 vector %d: got 0x%016x want 0x%016x", i, h, vec64[i])
+		}
+
+		m128.Reset()
+		m128.Write(in[:i])
+		if h1, h2 := m128.Sum128(); h1 != vec128[i][0] || h2 != vec128[i][1] {
+			t.Errorf("siphash24_128 vector %d: got 0x%016x 0x%016x want 0x%016x 0x%016x", i, h1, h2, vec128[i][0], vec128[i][1])
+		}
+	}
+}
+
+// the HighwayHash key used for all the tests, 00 01 02 ... 1f
+var highwayKey = [4]uint64{0x0706050403020100, 0x0f0e0d0c0b0a0908, 0x1716151413121110, 0x1f1e1d1c1b1a1918}
+
+func TestHighwayHash64(t *testing.T) {
+
+	m := NewHighwayHash64(highwayKey)
+
+	testIncremental64(t, m, 0xcacefc4bdedeedcf, "highwayhash64")
+
+	testGolden64(t, m, goldenHighwayHash64, "highwayhash64")
+}
+
+func TestHighwayHash128(t *testing.T) {
+
+	m := NewHighwayHash128(highwayKey)
+
+	testIncremental128(t, m, 0xf084366628ddd0f3, 0xde6ca1781ca691b4, "highwayhash128")
+
+	testGolden128(t, m, goldenHighwayHash128, "highwayhash128")
+}
+
+func TestHighwayHash256(t *testing.T) {
+
+	m := NewHighwayHash256(highwayKey)
+
+	testIncremental256(t, m, [4]uint64{0x1504a68b86b0d55a, 0x452e6b18d9cc244c, 0xc2320ab00173f252, 0x0be601fcde50efb3}, "highwayhash256")
+
+	testGolden256(t, m, goldenHighwayHash256, "highwayhash256")
+}
+
+// the test vectors from the reference implementation: the key is 00 01 02 ... 1f, and the
+// input for vector i is the i bytes 00 01 02 ... i-1
+func TestHighwayHashVectors(t *testing.T) {
+
+	vec64 := []uint64{
+		0x907a56de22c26e53,
+		0x7eab43aac7cddd78,
+		0xb8d0569ab0b53d62,
+		0x5c6befab8a463d80,
+		0xf205a46893007eda,
+		0x2b8a1668e4a94541,
+		0xbd4ccc325befca6f,
+		0x4d02ae1738f59482,
+		0xe1205108e55f3171,
+		0x32d2644ec77a1584,
+		0xf6e10acdb103a90b,
+		0xc3bbf4615b415c15,
+		0x243cc2040063fa9c,
+		0xa89a58ce65e641ff,
+		0x24b031a348455a23,
+		0x40793f86a449f33b,
+		0xcfab3489f97eb832,
+		0x19fe67d2c8c5c0e2,
+		0x04dd90a69c565cc2,
+		0x75d9518e2371c504,
+		0x38ad9b1141d3dd16,
+		0x0264432ccd8a70e0,
+		0xa9db5a6288683390,
+		0xd7b05492003f028c,
+		0x205f615aea59e51e,
+		0xeee0c89621052884,
+		0x1bfc1a93a7284f4f,
+		0x512175b5b70da91d,
+		0xf71f8976a0a2c639,
+		0xae093fef1f84e3e7,
+		0x22ca92b01161860f,
+		0x9fc7007ccf035a68,
+		0xa0c964d9ecd580fc,
+		0x2c90f73ca03181fc,
+		0x185cf84e5691eb9e,
+		0x4fc1f5ef2752aa9b,
+		0xf5b7391a5e0a33eb,
+		0xb9b84b83b4e96c9c,
+		0x5e42fe712a5cd9b4,
+		0xa150f2f90c3f97dc,
+		0x7fa522d75e2d637d,
+		0x181ad0cc0dffd32b,
+		0x3889ed981e854028,
+		0xfb4297e8c586ee2d,
+		0x6d064a45bb28059c,
+		0x90563609b3ec860c,
+		0x7aa4fce94097c666,
+		0x1326bac06b911e08,
+		0xb926168d2b154f34,
+		0x9919848945b1948d,
+		0xa2a98fc534825ebe,
+		0xe9809095213ef0b6,
+		0x582e5483707bc0e9,
+		0x086e9414a88a6af5,
+		0xee86b98d20f6743d,
+		0xf89b7ff609b1c0a7,
+		0x4c7d9cc19e22c3e8,
+		0x9a97005024562a6f,
+		0x5dd41cf423e6ebef,
+		0xdf13609c0468e227,
+		0x6e0da4f64188155a,
+		0xb755ba4b50d7d4a1,
+		0x887a3484647479bd,
+		0xab8eebe9bf2139a0,
+		0x75542c5d4cd2a6ff,
+	}
+
+	vec128 := [][2]uint64{
+		{0x0fed268f9d8ffec7, 0x33565e767f093e6f},
+		{0xd6b0a8893681e7a8, 0xdc291df9eb9cdcb4},
+		{0x3d15ad265a16da04, 0x78085638dc32e868},
+		{0x0607621b295f0beb, 0xbfe69a0fd9cedd79},
+		{0x26399eb46dace49e, 0x2e922ad039319208},
+		{0x3250bdc386d12ed8, 0x193810906c63c23a},
+		{0x6f476ab3cb896547, 0x7cde576f37ed1019},
+		{0x2a401fca697171b4, 0xbe1f03ff9f02796c},
+		{0xa1e96d84280552e8, 0x695cf1c63bec0ac2},
+		{0x142a2102f31e63b2, 0x1a85b98c5b5000cc},
+		{0x51a1b70e26b6bc5b, 0x929e1f3b2da45559},
+		{0x88990362059a415b, 0xbed21f22c47b7d13},
+		{0xcd1f1f5f1caf9566, 0xa818ba8ce0f9c8d4},
+		{0xa225564112fe6157, 0xb2e94c78b8ddb848},
+		{0xbd492febd1cc0919, 0xcecd1dbc025641a2},
+		{0x142237a52bc4af54, 0xe0796c0b6e26bcd7},
+		{0x414460ffd5a401ad, 0x029ea3d5019f18c8},
+		{0xc52a4b96c51c9962, 0xecb878b1169b5ea0},
+		{0xd940ca8f11fbeace, 0xf93a46d616f8d531},
+		{0x8ac49d0ae5c0cbf5, 0x3ffdbf8df51d7c93},
+		{0xac6d279b852d00a8, 0x7dcd3a6ba5ebaa46},
+		{0xf11621bd93f08a56, 0x3173c398163dd9d5},
+		{0x0c4ce250f68cf89f, 0xb3123cda411898ed},
+		{0x15ab97ed3d9a51ce, 0x7ce274479169080e},
+		{0xcd001e198d4845b8, 0xd0d9d98bd8aa2d77},
+		{0x34f3d617a0493d79, 0x7dd304f6397f7e16},
+		{0x5cb56890a9f4c6b6, 0x130829166567304f},
+		{0x30da6f8b245bd1c0, 0x6f828b7e3fd9748c},
+		{0xe0580349204c12c0, 0x93f6da0cac5f441c},
+		{0xf648731ba5073045, 0x5fb897114fb65976},
+		{0x024f8354738a5206, 0x509a4918eb7e0991},
+		{0x06e7b465e8a57c29, 0x52415e3a07f5d446},
+		{0x1984df66c1434aaa, 0x16fc1958f9b3e4b9},
+		{0x111678afe0c6c36c, 0xf958b59de5a2849d},
+		{0x773fbc8440fb0490, 0xc96ed5d243658536},
+		{0x91e3dc710bb6c941, 0xea336a0bc1eeace9},
+		{0x25cfe3815d7ad9d4, 0xf2e94f8c828fc59e},
+		{0xb9fb38b83cc288f2, 0x7479c4c8f850ec04},
+		{0x1d85d5c525982b8c, 0x6e26b1c16f48dbf4},
+		{0x8a4e55bd6060bde7, 0x2134d599058b3fd0},
+		{0x2a958ff994778f36, 0xe8052d1ae61d6423},
+		{0x89233ae6be453233, 0x3acf9c87d7e8c0b9},
+		{0x4458f5e27ea9c8d5, 0x418fb49bca2a5140},
+		{0x090301837ed12a68, 0x1017f69633c861e6},
+		{0x330dd84704d49590, 0x339df1ad3a4ba6e4},
+		{0x569363a663f2c576, 0x363b3d95e3c95ef6},
+		{0xacc8d08586b90737, 0x2ba0e8087d4e28e9},
+		{0x39c27a27c86d9520, 0x8db620a45160932e},
+		{0x8e6a4aeb671a072d, 0x6ed3561a10e47ee6},
+		{0x0011d765b1bec74a, 0xd80e6e656ede842e},
+		{0x2515d62b936ac64c, 0xce088794d7088a7d},
+		{0x91621552c16e23af, 0x264f0094eb23ccef},
+		{0x1e21880d97263480, 0xd8654807d3a31086},
+		{0x39d76aaf097f432d, 0xa517e1e09d074739},
+		{0x0f17a4f337c65a14, 0x2f51215f69f976d4},
+		{0xa0fb5cda12895e44, 0x568c3dc4d1f13cd1},
+		{0x93c8fc00d89c46ce, 0xbad5da947e330e69},
+		{0x817c07501d1a5694, 0x584d6ee72cbfac2b},
+		{0x91d668af73f053bf, 0xf98e647683c1e0ed},
+		{0x5281e1ef6b3ccf8b, 0xbc4cc3df166083d8},
+		{0xaad61b6dbeaaeeb9, 0xff969d000c16787b},
+		{0x4325d84fc0475879, 0x14b919bd905f1c2d},
+		{0x79a176d1aa6ba6d1, 0xf1f720c5a53a2b86},
+		{0x74bd7018022f3ef0, 0x3aea94a8ad5f4bcb},
+		{0x98bb1f7198d4c4f2, 0xe0bc0571de918fc8},
+	}
+
+	vec256 := [][4]uint64{
+		{0xdd44482ac2c874f5, 0xd946017313c7351f, 0xb3aebeccb98714ff, 0x41da233145751df4},
+		{0xedb941bce45f8254, 0xe20d44ef3dcac60f, 0x72651b9bcb324a47, 0x2073624cb275e484},
+		{0x3fdff9df24afe454, 0x11c4bf1a1b0ae873, 0x115169cc6922597a, 0x1208f6590d33b42c},
+		{0x480aa0d70dd1d95c, 0x89225e7c6911d1d0, 0x8ea8426b8bbb865a, 0xe23dfbc390e1c722},
+		{0xc9cfc497212be4dc, 0xa85f9df6afd2929b, 0x1fda9f211df4109e, 0x07e4277a374d4f9b},
+		{0xb4b4f566a4dc85b3, 0xbf4b63ba5e460142, 0x15f48e68cddc1de3, 0x0f74587d388085c6},
+		{0x6445c70a86adb9b4, 0xa99cfb2784b4ceb6, 0xdae29d40a0b2db13, 0xb6526df29a9d1170},
+		{0xd666b1a00987ad81, 0xa4f1f838eb8c6d37, 0xe9226e07d463e030, 0x5754d67d062c526c},
+		{0xf1b905b0ed768bc0, 0xe6976ff3fcff3a45, 0x4fbe518dd9d09778, 0xd9a0afeb371e0d33},
+		{0x80d8e4d70d3c2981, 0xf10fbbd16424f1a1, 0xcf5c2dbe9d3f0cd1, 0xc0bfe8f701b673f2},
+		{0xade48c50e5a262be, 0x8e9492b1fdfe38e0, 0x0784b74b2fe9b838, 0x0e41d574db656dcd},
+		{0xa1be77b9531807cf, 0xba97a7de6a1a9738, 0xaf274cef9c8e261f, 0x3e39b935c74ce8e8},
+		{0x15ad3802e3405857, 0x9d11cbdc39e853a0, 0x23ea3e993c31b225, 0x6cd9e9e3caf4212e},
+		{0x01c96f5eb1d77c36, 0xa367f9c1531f95a6, 0x1f94a3427cdadcb8, 0x97f1000abf3bd5d3},
+		{0x0815e91eeeff8e41, 0x0e0c28fa6e21df5d, 0x4ead8e62ed095374, 0x3ffd01da1c9d73e6},
+		{0xc11905707842602e, 0x62c3db018501b146, 0x85f5ad17fa3406c1, 0xc884f87bd4fec347},
+		{0xf51ad989a1b6cd1f, 0xf7f075d62a627bd9, 0x7e01d5f579f28a06, 0x1ad415c16a174d9f},
+		{0x19f4cfa82ca4068e, 0x3b9d4abd3a9275b9, 0x8000b0dde9c010c6, 0x8884d50949215613},
+		{0x126d6c7f81ab9f5d, 0x4edaa3c5097716ee, 0xaf121573a7dd3e49, 0x9001ac85aa80c32d},
+		{0x06aabef9149155fa, 0xdf864f4144e71c3d, 0xfdbabce860bc64da, 0xde2ba54792491cb6},
+		{0xadfc6b4035079fdb, 0xa087b7328e486e65, 0x46d1a9935a4623ea, 0xe3895c440d3cee44},
+		{0xb5f9d31deea3b3df, 0x8f3024e20a06e133, 0xf24c38c8288fe120, 0x703f1dcf9bd69749},
+		{0x2b3c0b854794efe3, 0x1c5d3f969bdacea0, 0x81f16aafa563ac2e, 0x23441c5a79d03075},
+		{0x418af8c793fd3762, 0xbc6b8e9461d7f924, 0x776ff26a2a1a9e78, 0x3aa0b7bfd417ca6e},
+		{0xcd03ea2ad255a3c1, 0x0185fee5b59c1b2a, 0xd1f438d44f9773e4, 0xbe69dd67f83b76e4},
+		{0xf951a8873887a0fb, 0x2c7b31d2a548e0ae, 0x44803838b6186efa, 0xa3c78ec7be219f72},
+		{0x958ff151ea0d8c08, 0x4b7e8997b4f63488, 0xc78e074351c5386d, 0xd95577556f20eefa},
+		{0x29a917807fb05406, 0x3318f884351f578c, 0xdd24ea6ef6f6a7fa, 0xe74393465e97aeff},
+		{0x98240880935e6ccb, 0x1fd0d271b09f97da, 0x56e786472700b183, 0x291649f99f747817},
+		{0x1bd4954f7054c556, 0xffdb2eff7c596ceb, 0x7c6ac69a1bab6b5b, 0x0f037670537fc153},
+		{0x8825e38897597498, 0x647cf6ebaf6332c1, 0x552bd903dc28c917, 0x72d7632c00bfc5ab},
+		{0x6880e276601a644d, 0xb3728b20b10fb7da, 0xd0bd12060610d16e, 0x8aef14ef33452ef2},
+		{0xbce38c9039a1c3fe, 0x42d56326a3c11289, 0xe35595f764fcaea9, 0xc9b03c6bc9475a99},
+		{0xf60115cbf034a6e5, 0x6c36ea75bfce46d0, 0x3b17c8d382725990, 0x7edaa2ed11007a35},
+		{0x1326e959edf9dea2, 0xc4776801739f720c, 0x5169500fd762f62f, 0x8a0dd0d90a2529ab},
+		{0x935149d503d442d4, 0xff6bb41302dad144, 0x339cb012cd9d36ec, 0xe61d53619ecc2230},
+		{0x528bc888aa50b696, 0xb8aeeca36084e1fc, 0xa158151ec0243476, 0x02c14aad097cec44},
+		{0xbed688a72217c327, 0x1ee65114f760873f, 0x3f5c26b37d3002a6, 0xddf2e895631597b9},
+		{0xe7db21cf2b0b51ad, 0xfafc6324f4b0ab6c, 0xb0857244c22d9c5b, 0xf0ad888d1e05849c},
+		{0x05519793cd4dcb00, 0x3c594a3163067deb, 0xac75081acf119e34, 0x5ac86297805cb094},
+		{0x09228d8c22b5779e, 0x19644db2516b7e84, 0x2b92c8abf83141a0, 0x7f785ad725e19391},
+		{0x59c42e5d46d0a74b, 0x5ea53c65ca036064, 0x48a9916bb635aeb4, 0xbae6df143f54e9d4},
+		{0x5eb623696d03d0e3, 0xd53d78bcb41da092, 0xfe2348dc52f6b10d, 0x64802457632c8c11},
+		{0x43b61bb2c4b85481, 0xc6318c25717e80a1, 0x8c4a7f4d6f9c687d, 0xbd0217e035401d7c},
+		{0x7f51ca5743824c37, 0xb04c4d5eb11d703a, 0x4d511e1ecbf6f369, 0xd66775ea215456e2},
+		{0x39b409eef87e45cc, 0x52b8e8c459fc79b3, 0x44920918d1858c24, 0x80f07b645eee0149},
+		{0xce8694d1be9ad514, 0xbfa19026526836e7, 0x1ea4fdf6e4902a7d, 0x380c4458d696e1fe},
+		{0xd189e18bf823a0a4, 0x1f3b353be501a7d7, 0xa24f77b4e02e2884, 0x7e94646f74f9180c},
+		{0xaff8c635d325ec48, 0x2c2e0aa414038d0b, 0x4ed37f611a447467, 0x39ec38e33b501489},
+		{0x2a2bfdad5f83f197, 0x013d3e6ebef274cc, 0xe1563c0477726155, 0xf15a8a5de932037e},
+		{0xd5d1f91ec8126332, 0x10110b9bf9b1ff11, 0xa175ab26541c6032, 0x87badc5728701552},
+		{0xc7b5a92cd8082884, 0xdda62ab61b2eeefb, 0x8f9882ecfeae732f, 0x6b38bd5cc01f4ffb},
+		{0xcf6ef275733d32f0, 0xa3f0822da2bf7d8b, 0x304e7435f512406a, 0x0b28e3efebb3172d},
+		{0xe698f80701b2e9db, 0x66ae2a819a8a8828, 0x14ea9024c9b8f2c9, 0xa7416170523eb5a4},
+		{0x3a917e87e307edb7, 0x17b4dedae34452c1, 0xf689f162e711cc70, 0x29ce6bfe789cdd0e},
+		{0x0eff3ad8cb155d8e, 0x47cd9ead4c0844a2, 0x46c8e40ee6fe21eb, 0xdef3c25df0340a51},
+		{0x03fd86e62b82d04d, 0x32ab0d600717136d, 0x682b0e832b857a89, 0x138ce3f1443739b1},
+		{0x2f77c754c4d7f902, 0x1053e0a9d9adbfea, 0x58e66368544ae70a, 0xc48a829c72dd83ca},
+		{0xf900eb19e466a09f, 0x31be9e01a8c7d314, 0x3afec6b8ca08f471, 0xb8c0eb0f87ffe7fb},
+		{0xdb277d8fbe3c8efb, 0x53ce6877e11aa57b, 0x719c94d20d9a7e7d, 0xb345b56392453cc9},
+		{0x37639c3bdba4f2c9, 0x6095e7b336466dc8, 0x3a8049791e65b88a, 0x82c988cde5927cd5},
+		{0x6b1fb1a714234ae4, 0x20562e255ba6467e, 0x3e2b892d40f3d675, 0xf40ce3fbe41ed768},
+		{0x8ee11cb1b287c92a, 0x8fc2aaeff63d266d, 0x66643487e6eb9f03, 0x578aa91de8d56873},
+		{0xf5b1f8266a3aeb67, 0x83b040be4dec1add, 0x7fe1c8635b26fbae, 0xf4a3a447defed79f},
+		{0x90d8e6ff6ac12475, 0x1a422a196edac1f2, 0x9e3765fe1f8eb002, 0xc1bdd7c4c351cfbe},
+	}
+
+	in := make([]byte, len(vec64))
+
+	for i := range in {
+		in[i] = byte(i)
+	}
+
+	m := NewHighwayHash64(highwayKey)
+	m128 := NewHighwayHash128(highwayKey)
+	m256 := NewHighwayHash256(highwayKey)
+
+	for i := range vec64 {
+		m.Reset()
+		m.Write(in[:i])
+		if h := m.Sum64(); h != vec64[i] {
+			t.Errorf("highwayhash64 vector %d: got 0x%016x want 0x%016x", i, h, vec64[i])
+		}
+
+		m128.Reset()
+		m128.Write(in[:i])
+		if h1, h2 := m128.Sum128(); h1 != vec128[i][0] || h2 != vec128[i][1] {
+			t.Errorf("highwayhash128 vector %d: got 0x%016x 0x%016x want 0x%016x 0x%016x", i, h1, h2, vec128[i][0], vec128[i][1])
+		}
+
+		// feed the 256-bit hash one byte at a time to exercise the buffering
+		m256.Reset()
+		for j := 0; j < i; j++ {
+			m256.Write(in[j : j+1])
+		}
+		if h1, h2, h3, h4 := m256.Sum256(); [4]uint64{h1, h2, h3, h4} != vec256[i] {
+			t.Errorf("highwayhash256 vector %d: got %016x want %016x", i, [4]uint64{h1, h2, h3, h4}, vec256[i])
+		}
+	}
+}
+
+func TestCityHash32(t *testing.T) {
+
+	m := NewCityHash32()
+
+	testIncremental(t, m, 0x8d8b18f6, "cityhash32")
+
+	testGolden(t, m, goldenCityHash32, "cityhash32")
+
+	for _, g := range goldenCityHash32 {
+		if h := CityHash32([]byte(g.in)); h != g.out {
+			t.Errorf("CityHash32(%s) = 0x%x want 0x%x", g.in, h, g.out)
+		}
+	}
+}
+
+func TestCityHash64(t *testing.T) {
+
+	m := NewCityHash64()
+
+	testIncremental64(t, m, 0x7152a1b1f9b4d7db, "cityhash64")
+
+	testGolden64(t, m, goldenCityHash64, "cityhash64")
+
+	for _, g := range goldenCityHash64 {
+		if h := CityHash64([]byte(g.in)); h != g.out {
+			t.Errorf("CityHash64(%s) = 0x%x want 0x%x", g.in, h, g.out)
+		}
+	}
+}
+
+func TestCityHash128(t *testing.T) {
+
+	m := NewCityHash128()
+
+	testIncremental128(t, m, 0xfb5390ca139cb5d4, 0xf25a8599fd0fd3b1, "cityhash128")
+
+	testGolden128(t, m, goldenCityHash128, "cityhash128")
+
+	for _, g := range goldenCityHash128 {
+		if h1, h2 := CityHash128([]byte(g.in)); h1 != g.h1 || h2 != g.h2 {
+			t.Errorf("CityHash128(%s) = 0x%016x 0x%016x want 0x%016x 0x%016x", g.in, h1, h2, g.h1, g.h2)
+		}
+	}
+}
+
+// the same checks as the reference city-test.cc
+func TestCityHashReference(t *testing.T) {
+
+	const seed0, seed1 = 1234567, 0xc3a5c85c97cb3127
+
+	data := cityTestData()
+
+	check := func(e *[8]uint64, offset, length int) {
+		s := data[offset : offset+length]
+
+		got := [8]uint64{
+			0: CityHash64(s),
+			1: CityHash64WithSeed(s, seed0),
+			2: CityHash64WithSeeds(s, seed0, seed1),
+			7: uint64(CityHash32(s)),
+		}
+		got[3], got[4] = CityHash128(s)
+		got[5], got[6] = CityHash128WithSeed(s, seed0, seed1)
+
+		if got != *e {
+			t.Errorf("cityhash(data[%d:%d]) = %x want %x", offset, offset+length, got, *e)
+		}
+	}
+
+	i := 0
+	for ; i < len(cityReference)-1; i++ {
+		check(&cityReference[i], i*i, i)
+	}
+	check(&cityReference[i], 0, len(data))
+
+	// the seeded hashes should agree with the functions they wrap
+	e := &cityReference[i]
+
+	m := NewCityHash64Seed(seed0)
+	m.Write(data[:10])
+	m.Write(data[10:])
+	if h := m.Sum64(); h != e[1] {
+		t.Errorf("NewCityHash64Seed: got 0x%016x want 0x%016x", h, e[1])
+	}
+
+	m = NewCityHash64Seeds(seed0, seed1)
+	m.Write(data)
+	if h := m.Sum64(); h != e[2] {
+		t.Errorf("NewCityHash64Seeds: got 0x%016x want 0x%016x", h, e[2])
+	}
+
+	m128 := NewCityHash128Seed(seed0, seed1)
+	m128.Write(data)
+	if h1, h2 := m128.Sum128(); h1 != e[5] || h2 != e[6] {
+		t.Errorf("NewCityHash128Seed: got 0x%016x 0x%016x want 0x%016x 0x%016x", h1, h2, e[5], e[6])
+	}
+}
+
+func TestFarmFingerprint(t *testing.T) {
+
+	m32 := NewFarmFingerprint32()
+
+	testIncremental(t, m32, 0xed7a9c7f, "farmfingerprint32")
+
+	testGolden(t, m32, goldenFarmFingerprint32, "farmfingerprint32")
+
+	m64 := NewFarmFingerprint64()
+
+	testIncremental64(t, m64, 0x7152a1b1f9b4d7db, "farmfingerprint64")
+
+	testGolden64(t, m64, goldenFarmFingerprint64, "farmfingerprint64")
+
+	// Fingerprint128 is CityHash128
+	testGolden128(t, NewFarmFingerprint128(), goldenCityHash128, "farmfingerprint128")
+}
+
+// the same checks as the Fingerprint64 self-test in the reference farmhash.cc
+func TestFarmFingerprint64Reference(t *testing.T) {
+
+	data := cityTestData()
+
+	index := 0
+
+	check := func(offset, length int) {
+		h := FarmFingerprint64(data[offset : offset+length])
+		if e := uint64(farmFingerprint64Reference[index])<<32 | uint64(farmFingerprint64Reference[index+1]); h != e {
+			t.Errorf("FarmFingerprint64(data[%d:%d]) = 0x%016x want 0x%016x", offset, offset+length, h, e)
+		}
+		index += 2
+	}
+
+	i := 0
+	for ; i < 299; i++ {
+		check(i*i, i)
+	}
+	for ; i < len(data); i += i / 7 {
+		check(0, i)
+	}
+	check(0, len(data))
+}
+
+func TestSpookyHash(t *testing.T) {
+
+	m := NewSpookyHash(0, 0)
+
+	testIncremental128(t, m, 0x0d72f64dc4af38e7, 0xe08972c2c98865c4, "spookyhash")
+
+	testGolden128(t, m, goldenSpookyHash128, "spookyhash")
+
+	for _, g := range goldenSpookyHash128 {
+		if h1, h2 := SpookyHash128([]byte(g.in), 0, 0); h1 != g.h1 || h2 != g.h2 {
+			t.Errorf("SpookyHash128(%s) = 0x%016x 0x%016x want 0x%016x 0x%016x", g.in, h1, h2, g.h1, g.h2)
+		}
+		if h := SpookyHash64([]byte(g.in), 0); h != g.h1 {
+			t.Errorf("SpookyHash64(%s) = 0x%016x want 0x%016x", g.in, h, g.h1)
+		}
+	}
+}
+
+// the same checks as the reference testspooky.cpp
+func TestSpookyHashReference(t *testing.T) {
+
+	var expected = []uint32{
+		0x6bf50919, 0x70de1d26, 0xa2b37298, 0x35bc5fbf, 0x8223b279, 0x5bcb315e, 0x53fe88a1, 0xf9f1a233,
+		0xee193982, 0x54f86f29, 0xc8772d36, 0x9ed60886, 0x5f23d1da, 0x1ed9f474, 0xf2ef0c89, 0x83ec01f9,
+		0xf274736c, 0x7e9ac0df, 0xc7aed250, 0xb1015811, 0xe23470f5, 0x48ac20c4, 0xe2ab3cd5, 0x608f8363,
+		0xd0639e68, 0xc4e8e7ab, 0x863c7c5b, 0x4ea63579, 0x99ae8622, 0x170c658b, 0x149ba493, 0x027bca7c,
+		0xe5cfc8b6, 0xce01d9d7, 0x11103330, 0x5d1f5ed4, 0xca720ecb, 0xef408aec, 0x733b90ec, 0x855737a6,
+		0x9856c65f, 0x647411f7, 0x50777c74, 0xf0f1a8b7, 0x9d7e55a5, 0xc68dd371, 0xfc1af2cc, 0x75728d0a,
+		0x390e5fdc, 0xf389b84c, 0xfb0ccf23, 0xc95bad0e, 0x5b1cb85a, 0x6bdae14f, 0x6deb4626, 0x93047034,
+		0x6f3266c6, 0xf529c3bd, 0x396322e7, 0x3777d042, 0x1cd6a5a2, 0x197b402e, 0xc28d0d2b, 0x09c1afb4,
+
+		0x069c8bb7, 0x6f9d4e1e, 0xd2621b5c, 0xea68108d, 0x8660cb8f, 0xd61e6de6, 0x7fba15c7, 0xaacfaa97,
+		0xdb381902, 0x4ea22649, 0x5d414a1e, 0xc3fc5984, 0xa0fc9e10, 0x347dc51c, 0x37545fb6, 0x8c84b26b,
+		0xf57efa5d, 0x56afaf16, 0xb6e1eb94, 0x9218536a, 0xe3cc4967, 0xd3275ef4, 0xea63536e, 0x6086e499,
+		0xaccadce7, 0xb0290d82, 0x4ebfd0d6, 0x46ccc185, 0x2eeb10d3, 0x474e3c8c, 0x23c84aee, 0x3abae1cb,
+		0x1499b81a, 0xa2993951, 0xeed176ad, 0xdfcfe84c, 0xde4a961f, 0x4af13fe6, 0xe0069c42, 0xc14de8f5,
+		0x6e02ce8f, 0x90d19f7f, 0xbca4a484, 0xd4efdd63, 0x780fd504, 0xe80310e3, 0x03abbc12, 0x90023849,
+		0xd6f6fb84, 0xd6b354c5, 0x5b8575f0, 0x758f14e4, 0x450de862, 0x90704afb, 0x47209a33, 0xf226b726,
+		0xf858dab8, 0x7c0d6de9, 0xb05ce777, 0xee5ff2d4, 0x7acb6d5c, 0x2d663f85, 0x41c72a91, 0x82356bf2,
+
+		0x94e948ec, 0xd358d448, 0xeca7814d, 0x78cd7950, 0xd6097277, 0x97782a5d, 0xf43fc6f4, 0x105f0a38,
+		0x9e170082, 0x4bfe566b, 0x4371d25f, 0xef25a364, 0x698eb672, 0x74f850e4, 0x4678ff99, 0x4a290dc6,
+		0x3918f07c, 0x32c7d9cd, 0x9f28e0af, 0x0d3c5a86, 0x7bfc8a45, 0xddf0c7e1, 0xdeacb86b, 0x970b3c5c,
+		0x5e29e199, 0xea28346d, 0x6b59e71b, 0xf8a8a46a, 0x862f6ce4, 0x3ccb740b, 0x08761e9e, 0xbfa01e5f,
+		0xf17cfa14, 0x2dbf99fb, 0x7a0be420, 0x06137517, 0xe020b266, 0xd25bfc61, 0xff10ed00, 0x42e6be8b,
+		0x029ef587, 0x683b26e0, 0xb08afc70, 0x7c1fd59e, 0xbaae9a70, 0x98c8c801, 0xb6e35a26, 0x57083971,
+		0x90a6a680, 0x1b44169e, 0x1dce237c, 0x518e0a59, 0xccb11358, 0x7b8175fb, 0xb8fe701a, 0x10d259bb,
+		0xe806ce10, 0x9212be79, 0x4604ae7b, 0x7fa22a84, 0xe715b13a, 0x0394c3b2, 0x11efbbae, 0xe13d9e19,
+
+		0x77e012bd, 0x2d05114c, 0xaecf2ddd, 0xb2a2b4aa, 0xb9429546, 0x55dce815, 0xc89138f8, 0x46dcae20,
+		0x1f6f7162, 0x0c557ebc, 0x5b996932, 0xafbbe7e2, 0xd2bd5f62, 0xff475b9f, 0x9cec7108, 0xeaddcffb,
+		0x5d751aef, 0xf68f7bdf, 0xf3f4e246, 0x00983fcd, 0x00bc82bb, 0xbf5fd3e7, 0xe80c7e2c, 0x187d8b1f,
+		0xefafb9a7, 0x8f27a148, 0x5c9606a9, 0xf2d2be3e, 0xe992d13a, 0xe4bcd152, 0xce40b436, 0x63d6a1fc,
+		0xdc1455c4, 0x64641e39, 0xd83010c9, 0x2d535ae0, 0x5b748f3e, 0xf9a9146b, 0x80f10294, 0x2859acd4,
+		0x5fc846da, 0x56d190e9, 0x82167225, 0x98e4daba, 0xbf7865f3, 0x00da7ae4, 0x9b7cd126, 0x644172f8,
+		0xde40c78f, 0xe8803efc, 0xdd331a2b, 0x48485c3c, 0x4ed01ddc, 0x9c0b2d9e, 0xb1c6e9d7, 0xd797d43c,
+		0x274101ff, 0x3bf7e127, 0x91ebbc56, 0x7ffeb321, 0x4d42096f, 0xd6e9456a, 0x0bade318, 0x2f40ee0b,
+
+		0x38cebf03, 0x0cbc2e72, 0xbf03e704, 0x7b3e7a9a, 0x8e985acd, 0x90917617, 0x413895f8, 0xf11dde04,
+		0xc66f8244, 0xe5648174, 0x6c420271, 0x2469d463, 0x2540b033, 0xdc788e7b, 0xe4140ded, 0x0990630a,
+		0xa54abed4, 0x6e124829, 0xd940155a, 0x1c8836f6, 0x38fda06c, 0x5207ab69, 0xf8be9342, 0x774882a8,
+		0x56fc0d7e, 0x53a99d6e, 0x8241f634, 0x9490954d, 0x447130aa, 0x8cc4a81f, 0x0868ec83, 0xc22c642d,
+		0x47880140, 0xfbff3bec, 0x0f531f41, 0xf845a667, 0x08c15fb7, 0x1996cd81, 0x86579103, 0xe21dd863,
+		0x513d7f97, 0x3984a1f1, 0xdfcdc5f4, 0x97766a5e, 0x37e2b1da, 0x41441f3f, 0xabd9ddba, 0x23b755a9,
+		0xda937945, 0x103e650e, 0x3eef7c8f, 0x2760ff8d, 0x2493a4cd, 0x1d671225, 0x3bf4bd4c, 0xed6e1728,
+		0xc70e9e30, 0x4e05e529, 0x928d5aa6, 0x164d0220, 0xb5184306, 0x4bd7efb3, 0x63830f11, 0xf3a1526c,
+
+		0xf1545450, 0xd41d5df5, 0x25a5060d, 0x77b368da, 0x4fe33c7e, 0xeae09021, 0xfdb053c4, 0x2930f18d,
+		0xd37109ff, 0x8511a781, 0xc7e7cdd7, 0x6aeabc45, 0xebbeaeaa, 0x9a0c4f11, 0xda252cbb, 0x5b248f41,
+		0x5223b5eb, 0xe32ab782, 0x8e6a1c97, 0x11d3f454, 0x3e05bd16, 0x0059001d, 0xce13ac97, 0xf83b2b4c,
+		0x71db5c9a, 0xdc8655a6, 0x9e98597b, 0x3fcae0a2, 0x75e63ccd, 0x076c72df, 0x4754c6ad, 0x26b5627b,
+		0xd818c697, 0x998d5f3d, 0xe94fc7b2, 0x1f49ad1a, 0xca7ff4ea, 0x9fe72c05, 0xfbd0cbbf, 0xb0388ceb,
+		0xb76031e3, 0xd0f53973, 0xfb17907c, 0xa4c4c10f, 0x9f2d8af9, 0xca0e56b0, 0xb0d9b689, 0xfcbf37a3,
+		0xfede8f7d, 0xf836511c, 0x744003fc, 0x89eba576, 0xcfdcf6a6, 0xc2007f52, 0xaaaf683f, 0x62d2f9ca,
+		0xc996f77f, 0x77a7b5b3, 0x8ba7d0a4, 0xef6a0819, 0xa0d903c0, 0x01b27431, 0x58fffd4c, 0x4827f45c,
+
+		0x44eb5634, 0xae70edfc, 0x591c740b, 0x478bf338, 0x2f3b513b, 0x67bf518e, 0x6fef4a0c, 0x1e0b6917,
+		0x5ac0edc5, 0x2e328498, 0x077de7d5, 0x5726020b, 0x2aeda888, 0x45b637ca, 0xcf60858d, 0x3dc91ae2,
+		0x3e6d5294, 0xe6900d39, 0x0f634c71, 0x827a5fa4, 0xc713994b, 0x1c363494, 0x3d43b615, 0xe5fe7d15,
+		0xf6ada4f2, 0x472099d5, 0x04360d39, 0x7f2a71d0, 0x88a4f5ff, 0x2c28fac5, 0x4cd64801, 0xfd78dd33,
+		0xc9bdd233, 0x21e266cc, 0x9bbf419d, 0xcbf7d81d, 0x80f15f96, 0x04242657, 0x53fb0f66, 0xded11e46,
+		0xf2fdba97, 0x8d45c9f1, 0x4eeae802, 0x17003659, 0xb9db81a7, 0xe734b1b2, 0x9503c54e, 0xb7c77c3e,
+		0x271dd0ab, 0xd8b906b5, 0x0d540ec6, 0xf03b86e0, 0x0fdb7d18, 0x95e261af, 0xad9ec04e, 0x381f4a64,
+		0xfec798d7, 0x09ea20be, 0x0ef4ca57, 0x1e6195bb, 0xfd0da78b, 0xcea1653b, 0x157d9777, 0xf04af50f,
+
+		0xad7baa23, 0xd181714a, 0x9bbdab78, 0x6c7d1577, 0x645eb1e7, 0xa0648264, 0x35839ca6, 0x2287ef45,
+		0x32a64ca3, 0x26111f6f, 0x64814946, 0xb0cddaf1, 0x4351c59e, 0x1b30471c, 0xb970788a, 0x30e9f597,
+		0xd7e58df1, 0xc6d2b953, 0xf5f37cf4, 0x3d7c419e, 0xf91ecb2d, 0x9c87fd5d, 0xb22384ce, 0x8c7ac51c,
+		0x62c96801, 0x57e54091, 0x964536fe, 0x13d3b189, 0x4afd1580, 0xeba62239, 0xb82ea667, 0xae18d43a,
+		0xbef04402, 0x1942534f, 0xc54bf260, 0x3c8267f5, 0xa1020ddd, 0x112fcc8a, 0xde596266, 0xe91d0856,
+		0xf300c914, 0xed84478e, 0x5b65009e, 0x4764da16, 0xaf8e07a2, 0x4088dc2c, 0x9a0cad41, 0x2c3f179b,
+		0xa67b83f7, 0xf27eab09, 0xdbe10e28, 0xf04c911f, 0xd1169f87, 0x8e1e4976, 0x17f57744, 0xe4f5a33f,
+		0x27c2e04b, 0x0b7523bd, 0x07305776, 0xc6be7503, 0x918fa7c9, 0xaf2e2cd9, 0x82046f8e, 0xcc1c8250,
+	}
+
+	var buf [512]byte
+
+	m := NewSpookyHash(0, 0)
+
+	for i := range expected {
+		if h := SpookyHash32(buf[:i], 0); h != expected[i] {
+			t.Errorf("SpookyHash32(buf[:%d]) = 0x%08x want 0x%08x", i, h, expected[i])
+		}
+
+		// write in odd-sized pieces to cover both short and long messages
+		m.Reset()
+		for p := buf[:i]; len(p) > 0; {
+			n := len(p)
+			if n > 37 {
+				n = 37
+			}
+			m.Write(p[:n])
+			p = p[n:]
+		}
+		if h := uint32(m.Sum64()); h != expected[i] {
+			t.Errorf("spookyhash incremental buf[:%d] = 0x%08x want 0x%08x", i, h, expected[i])
+		}
+
+		// note: doesn't include the item we just added
+		buf[i] = byte(i + 128)
+	}
+}
+
+func TestJenkinsLookup2(t *testing.T) {
+
+	m := NewJenkinsLookup2(0)
+
+	testIncremental(t, m, 0xdf0f97cf, "lookup2")
+
+	testGolden(t, m, goldenJenkinsLookup2, "lookup2")
+
+	for _, g := range goldenJenkinsLookup2 {
+		if h := JenkinsLookup2([]byte(g.in), 0); h != g.out {
+			t.Errorf("JenkinsLookup2(%s) = 0x%x want 0x%x", g.in, h, g.out)
+		}
+	}
+}
+
+func TestJenkinsHashLittle(t *testing.T) {
+
+	m := NewJenkinsHashLittle(0)
+
+	testIncremental(t, m, 0x71a3d88d, "hashlittle")
+
+	testGolden(t, m, goldenJenkinsHashLittle, "hashlittle")
+
+	m2 := NewJenkinsHashLittle2(0, 0)
+
+	testIncremental64(t, m2, 0x6419477971a3d88d, "hashlittle2")
+
+	testGolden64(t, m2, goldenJenkinsHashLittle2, "hashlittle2")
+}
+
+func TestJenkinsHashBig(t *testing.T) {
+
+	m := NewJenkinsHashBig(0)
+
+	testIncremental(t, m, 0x7f1354c8, "hashbig")
+
+	testGolden(t, m, goldenJenkinsHashBig, "hashbig")
+}
+
+// the same checks as driver5() in the reference lookup3.c
+func TestJenkinsLookup3Reference(t *testing.T) {
+
+	var tests = []struct {
+		in     string
+		pc, pb uint32
+		c, b   uint32
+	}{
+		{"", 0, 0, 0xdeadbeef, 0xdeadbeef},
+		{"", 0, 0xdeadbeef, 0xbd5b7dde, 0xdeadbeef},
+		{"", 0xdeadbeef, 0xdeadbeef, 0x9c093ccd, 0xbd5b7dde},
+		{"Four score and seven years ago", 0, 0, 0x17770551, 0xce7226e6},
+		{"Four score and seven years ago", 0, 1, 0xe3607cae, 0xbd371de4},
+		{"Four score and seven years ago", 1, 0, 0xcd628161, 0x6cbea4b3},
+	}
+
+	for _, tt := range tests {
+		if c, b := JenkinsHashLittle2([]byte(tt.in), tt.pc, tt.pb); c != tt.c || b != tt.b {
+			t.Errorf("JenkinsHashLittle2(%q, 0x%x, 0x%x) = 0x%08x 0x%08x want 0x%08x 0x%08x", tt.in, tt.pc, tt.pb, c, b, tt.c, tt.b)
+		}
+		if tt.pb != 0 {
+			continue
+		}
+		if c := JenkinsHashLittle([]byte(tt.in), tt.pc); c != tt.c {
+			t.Errorf("JenkinsHashLittle(%q, 0x%x) = 0x%08x want 0x%08x", tt.in, tt.pc, c, tt.c)
+		}
+	}
+
+	// hashword() on a little-endian machine is hashlittle() over the same bytes
+	for _, g := range goldenJenkinsHashLittle {
+		if len(g.in)%4 != 0 {
+			continue
+		}
+		k := make([]uint32, len(g.in)/4)
+		for i := range k {
+			k[i] = binary.LittleEndian.Uint32([]byte(g.in[4*i:]))
+		}
+		if h := JenkinsHashWord(k, 0); h != g.out {
+			t.Errorf("JenkinsHashWord(%s) = 0x%x want 0x%x", g.in, h, g.out)
+		}
+	}
+}
+
+func TestRS(t *testing.T) {
+
+	m := NewRS32()
+
+	testIncremental(t, m, 0x37af75d0, "rs")
+
+	testGolden(t, m, goldenRS, "rs")
+}
+
+func TestJS(t *testing.T) {
+
+	m := NewJS32()
+
+	testIncremental(t, m, 0x79526151, "js")
+
+	testGolden(t, m, goldenJS, "js")
+}
+
+func TestPJW(t *testing.T) {
+
+	m := NewPJW32()
+
+	testIncremental(t, m, 0x0e78177f, "pjw")
+
+	testGolden(t, m, goldenPJW, "pjw")
+
+	// with 32-bit ints, PJW and ELF32 are the same function
+	testGolden(t, m, goldenElf32, "pjw elf32")
+}
+
+func TestBKDR(t *testing.T) {
+
+	m := NewBKDR32()
+
+	testIncremental(t, m, 0xeb3ba670, "bkdr")
+
+	testGolden(t, m, goldenBKDR, "bkdr")
+}
+
+func TestDEK(t *testing.T) {
+
+	m := NewDEK32()
+
+	testIncremental(t, m, 0xb6ed382d, "dek")
+
+	testGolden(t, m, goldenDEK, "dek")
+}
+
+func TestBP(t *testing.T) {
+
+	m := NewBP32()
+
+	testIncremental(t, m, 0x8cbb366f, "bp")
+
+	testGolden(t, m, goldenBP, "bp")
+}
+
+func TestAP(t *testing.T) {
+
+	m := NewAP32()
+
+	testIncremental(t, m, 0xe02b6405, "ap")
+
+	testGolden(t, m, goldenAP, "ap")
+}
+
+func TestKR1(t *testing.T) {
+
+	m := NewKR1_32()
+
+	testIncremental(t, m, 0x00000850, "kr1")
+
+	testGolden(t, m, goldenKR1, "kr1")
+}
+
+func TestKR2(t *testing.T) {
+
+	m := NewKR2_32()
+
+	testIncremental(t, m, 0xb0ba5680, "kr2")
+
+	testGolden(t, m, goldenKR2, "kr2")
+}
+
+func TestPearson(t *testing.T) {
+
+	table := PearsonTable(0)
+
+	m := NewPearson8(table)
+
+	testIncremental(t, m, 0x3d, "pearson8")
+
+	// Sum appends a single byte, so testGolden (which expects four) can't be used
+	for _, g := range goldenPearson8 {
+		m.Reset()
+		m.Write([]byte(g.in))
+		if h := m.Sum32(); h != g.out {
+			t.Errorf("pearson8(%s) = 0x%02x want 0x%02x", g.in, h, g.out)
+		}
+		if b := m.Sum(nil); len(b) != 1 || uint32(b[0]) != g.out {
+			t.Errorf("pearson8(%s) Sum(nil) = %x want %02x", g.in, b, g.out)
+		}
+	}
+
+	m64 := NewPearson64(table)
+
+	testIncremental64(t, m64, 0x3d065558ac6d2298, "pearson64")
+
+	testGolden64(t, m64, goldenPearson64, "pearson64")
+
+	// the narrower hashes are the leading lanes of the wider ones
+	m16 := NewPearson16(table)
+	m32 := NewPearson32(table)
+	for _, g := range goldenPearson64 {
+		m16.Reset()
+		m16.Write([]byte(g.in))
+		if h := m16.Sum32(); h != uint32(g.out>>48) {
+			t.Errorf("pearson16(%s) = 0x%04x want 0x%04x", g.in, h, g.out>>48)
+		}
+		m32.Reset()
+		m32.Write([]byte(g.in))
+		if h := m32.Sum32(); h != uint32(g.out>>32) {
+			t.Errorf("pearson32(%s) = 0x%08x want 0x%08x", g.in, h, g.out>>32)
+		}
+	}
+
+	// a user-supplied table
+	var reversed [256]byte
+	for i := range reversed {
+		reversed[i] = byte(255 - i)
+	}
+	m64 = NewPearson64(reversed)
+	m64.Write([]byte("hello"))
+	if h := m64.Sum64(); h != 0x9d9c9f9e99989b9a {
+		t.Errorf("pearson64 reversed table = 0x%016x", h)
+	}
+
+	// a table from a different seed
+	m64 = NewPearson64(PearsonTable(0x5eed))
+	m64.Write([]byte("hello"))
+	if h := m64.Sum64(); h != 0xd699bcb669d8a40a {
+		t.Errorf("pearson64 seeded table = 0x%016x", h)
+	}
+}
+
+func TestPearsonTable(t *testing.T) {
+
+	for seed := uint64(0); seed < 100; seed++ {
+		var seen [256]bool
+		for _, v := range PearsonTable(seed) {
+			seen[v] = true
+		}
+		for i, ok := range seen {
+			if !ok {
+				t.Errorf("PearsonTable(%d) is not a permutation: missing %d", seed, i)
+				break
+			}
+		}
+	}
+
+	if PearsonTable(1) == PearsonTable(2) {
+		t.Errorf("PearsonTable(1) == PearsonTable(2)")
+	}
+}
+
+func TestWyHash(t *testing.T) {
+
+	m := NewWyHash(0)
+
+	testIncremental64(t, m, 0xa33a65625080aa7e, "wyhash")
+
+	testGolden64(t, m, goldenWyHash, "wyhash")
+
+	// the test vectors from the reference code, hashed with the index as the seed
+	var vectors = []_Golden64{
+		{0x93228a4de0eec5a2, ""},
+		{0xc5bac3db178713c4, "a"},
+		{0xa97f2f7b1d9b3314, "abc"},
+		{0x786d1f1df3801df4, "message digest"},
+		{0xdca5a8138ad37c87, "abcdefghijklmnopqrstuvwxyz"},
+		{0xb9e734f117cfaf70, "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"},
+		{0x6cc5eab49a92d617, "12345678901234567890123456789012345678901234567890123456789012345678901234567890"},
+	}
+
+	for i, v := range vectors {
+		if h := WyHash([]byte(v.in), uint64(i)); h != v.out {
+			t.Errorf("WyHash(%s, %d) = 0x%016x want 0x%016x", v.in, i, h, v.out)
+		}
+	}
+}
+
+func TestRapidHash(t *testing.T) {
+
+	m := NewRapidHash(0)
+
+	testIncremental64(t, m, 0xac6e1cf2ac1d9bf4, "rapidhash")
+
+	testGolden64(t, m, goldenRapidHash, "rapidhash")
+
+	testIncremental64(t, NewRapidHash(RapidHashSeed), 0xd0af04696acd9971, "rapidhash default seed")
+
+	for _, g := range goldenRapidHash {
+		if h := RapidHash([]byte(g.in), 0); h != g.out {
+			t.Errorf("RapidHash(%s) = 0x%016x want 0x%016x", g.in, h, g.out)
+		}
+	}
+}
+
+func TestMetroHash64(t *testing.T) {
+
+	testIncremental64(t, NewMetroHash64_1(20, 0), 0x971a83d6fd0aa994, "metrohash64_1")
+	testIncremental64(t, NewMetroHash64_2(20, 0), 0xf02828d51110f96a, "metrohash64_2")
+
+	for _, g := range goldenMetroHash64_1 {
+		if h := MetroHash64_1([]byte(g.in), 0); h != g.out {
+			t.Errorf("MetroHash64_1(%s) = 0x%016x want 0x%016x", g.in, h, g.out)
+		}
+
+		m := NewMetroHash64_1(len(g.in), 0)
+		for i := 0; i < len(g.in); i++ {
+			m.Write([]byte{g.in[i]})
+		}
+		if h := m.Sum64(); h != g.out {
+			t.Errorf("metrohash64_1 length(%s) = 0x%016x want 0x%016x", g.in, h, g.out)
+		}
+	}
+
+	for _, g := range goldenMetroHash64_2 {
+		if h := MetroHash64_2([]byte(g.in), 0); h != g.out {
+			t.Errorf("MetroHash64_2(%s) = 0x%016x want 0x%016x", g.in, h, g.out)
+		}
+	}
+
+	// the SMHasher verification values, written in pieces that straddle the 32-byte blocks
+	v := smhasherVerification(func(key []byte, seed uint32) []byte {
+		m := NewMetroHash64_1(len(key), seed)
+		for len(key) > 7 {
+			m.Write(key[:7])
+			key = key[7:]
+		}
+		m.Write(key)
+		return le64(m.Sum64())
+	})
+
+	if v != 0xee88f7d2 {
+		t.Errorf("metrohash64_1: verification failed: got %08x", v)
+	}
+
+	v = smhasherVerification(func(key []byte, seed uint32) []byte {
+		m := NewMetroHash64_2(len(key), seed)
+		for len(key) > 7 {
+			m.Write(key[:7])
+			key = key[7:]
+		}
+		m.Write(key)
+		return le64(m.Sum64())
+	})
+
+	if v != 0xe1fc7c6e {
+		t.Errorf("metrohash64_2: verification failed: got %08x", v)
+	}
+}
+
+func TestMetroHash128(t *testing.T) {
+
+	testIncremental128(t, NewMetroHash128_1(20, 0), 0xc67aeee81334e578, 0x586d47fa57b10a6f, "metrohash128_1")
+	testIncremental128(t, NewMetroHash128_2(20, 0), 0xec0d6fffa9716ccd, 0xe831748558178bd1, "metrohash128_2")
+
+	for _, g := range goldenMetroHash128_1 {
+		if h1, h2 := MetroHash128_1([]byte(g.in), 0); h1 != g.h1 || h2 != g.h2 {
+			t.Errorf("MetroHash128_1(%s) = 0x%016x 0x%016x want 0x%016x 0x%016x", g.in, h1, h2, g.h1, g.h2)
+		}
+	}
+
+	for _, g := range goldenMetroHash128_2 {
+		if h1, h2 := MetroHash128_2([]byte(g.in), 0); h1 != g.h1 || h2 != g.h2 {
+			t.Errorf("MetroHash128_2(%s) = 0x%016x 0x%016x want 0x%016x 0x%016x", g.in, h1, h2, g.h1, g.h2)
+		}
+	}
+
+	v := smhasherVerification(func(key []byte, seed uint32) []byte {
+		m := NewMetroHash128_1(len(key), seed)
+		for len(key) > 7 {
+			m.Write(key[:7])
+			key = key[7:]
+		}
+		m.Write(key)
+		h1, h2 := m.Sum128()
+		return append(le64(h1), le64(h2)...)
+	})
+
+	if v != 0x20e8a1d7 {
+		t.Errorf("metrohash128_1: verification failed: got %08x", v)
+	}
+
+	v = smhasherVerification(func(key []byte, seed uint32) []byte {
+		m := NewMetroHash128_2(len(key), seed)
+		for len(key) > 7 {
+			m.Write(key[:7])
+			key = key[7:]
+		}
+		m.Write(key)
+		h1, h2 := m.Sum128()
+		return append(le64(h1), le64(h2)...)
+	})
+
+	if v != 0x5437c684 {
+		t.Errorf("metrohash128_2: verification failed: got %08x", v)
+	}
+}
+
+func TestMulmod61(t *testing.T) {
+
+	const p = 1<<61 - 1
+
+	var tests = []struct {
+		a, b, out uint64
+	}{
+		{0, p - 1, 0},
+		{1, p - 1, p - 1},
+		{p - 1, p - 1, 1},
+		{1 << 60, 2, 1},
+		{1 << 40, 1 << 40, 1 << 19},
+		{p - 2, 2, p - 4},
+	}
+
+	for _, tt := range tests {
+		if r := mulmod61(tt.a, tt.b); r != tt.out {
+			t.Errorf("mulmod61(%d, %d) = %d want %d", tt.a, tt.b, r, tt.out)
+		}
+	}
+}
+
+func TestUniversalFamilies(t *testing.T) {
+
+	const key = 0xdeadbeef
+
+	in := []byte("hellohellohellohello")
+
+	var tests32 = []struct {
+		name string
+		f    func(seed uint64) (func(uint32) uint32, func([]byte) uint32)
+		h, s uint32
+	}{
+		{"tabulation32", func(seed uint64) (func(uint32) uint32, func([]byte) uint32) {
+			h := NewTabulation32(seed)
+			return h.Hash, h.HashBytes
+		}, 0xaa98d4af, 0x6d7d7a4f},
+		{"twisted tabulation32", func(seed uint64) (func(uint32) uint32, func([]byte) uint32) {
+			h := NewTwistedTabulation32(seed)
+			return h.Hash, h.HashBytes
+		}, 0xf14dfd51, 0x81d19fdf},
+		{"multiply-shift32", func(seed uint64) (func(uint32) uint32, func([]byte) uint32) {
+			h := NewMultiplyShift32(seed, 32)
+			return h.Hash, h.HashBytes
+		}, 0xf25305bb, 0xea607f08},
+		{"multiply-add-shift32", func(seed uint64) (func(uint32) uint32, func([]byte) uint32) {
+			h := NewMultiplyAddShift32(seed, 32)
+			return h.Hash, h.HashBytes
+		}, 0x35417760, 0x6656af36},
+	}
+
+	for _, tt := range tests32 {
+		h, s := tt.f(0)
+		if v := h(key); v != tt.h {
+			t.Errorf("%s: Hash(0x%x) = 0x%08x want 0x%08x", tt.name, key, v, tt.h)
+		}
+		if v := s(in); v != tt.s {
+			t.Errorf("%s: HashBytes(%s) = 0x%08x want 0x%08x", tt.name, in, v, tt.s)
+		}
+		if h1, _ := tt.f(1); h1(key) == h(key) {
+			t.Errorf("%s: seeds 0 and 1 give the same hash", tt.name)
+		}
+		if s([]byte("")) == s([]byte("\x00")) || s([]byte("a")) == s([]byte("a\x00\x00\x00\x00")) {
+			t.Errorf("%s: trailing zeros are ignored", tt.name)
+		}
+	}
+
+	var tests64 = []struct {
+		name string
+		f    func(seed uint64) (func(uint64) uint64, func([]byte) uint64)
+		h, s uint64
+	}{
+		{"tabulation64", func(seed uint64) (func(uint64) uint64, func([]byte) uint64) {
+			h := NewTabulation64(seed)
+			return h.Hash, h.HashBytes
+		}, 0x2f58eab813c4d4af, 0xbf317637aa81dcc2},
+		{"twisted tabulation64", func(seed uint64) (func(uint64) uint64, func([]byte) uint64) {
+			h := NewTwistedTabulation64(seed)
+			return h.Hash, h.HashBytes
+		}, 0xb7137a1974f5e5ff, 0xab463b4462d9d699},
+		{"multiply-shift64", func(seed uint64) (func(uint64) uint64, func([]byte) uint64) {
+			h := NewMultiplyShift64(seed, 64)
+			return h.Hash, h.HashBytes
+		}, 0x2e7d1a48f25305bb, 0xfe0977ffea607f08},
+		{"multiply-add-shift64", func(seed uint64) (func(uint64) uint64, func([]byte) uint64) {
+			h := NewMultiplyAddShift64(seed, 64)
+			return h.Hash, h.HashBytes
+		}, 0x2708d2f08bd4a932, 0xde8e7208b17e29fa},
+	}
+
+	for _, tt := range tests64 {
+		h, s := tt.f(0)
+		if v := h(key); v != tt.h {
+			t.Errorf("%s: Hash(0x%x) = 0x%016x want 0x%016x", tt.name, key, v, tt.h)
+		}
+		if v := s(in); v != tt.s {
+			t.Errorf("%s: HashBytes(%s) = 0x%016x want 0x%016x", tt.name, in, v, tt.s)
+		}
+		if h1, _ := tt.f(1); h1(key) == h(key) {
+			t.Errorf("%s: seeds 0 and 1 give the same hash", tt.name)
+		}
+		if s([]byte("")) == s([]byte("\x00")) || s([]byte("a")) == s([]byte("a\x00\x00\x00\x00")) {
+			t.Errorf("%s: trailing zeros are ignored", tt.name)
+		}
+	}
+}
+
+func TestMultiplyShift(t *testing.T) {
+
+	// the output fits in l bits
+	for _, l := range []uint{1, 7, 20, 32} {
+		m := NewMultiplyShift32(uint64(l), l)
+		a := NewMultiplyAddShift32(uint64(l), l)
+		for x := uint32(0); x < 1000; x++ {
+			if h := m.Hash(x * 0x9e3779b9); h>>l != 0 {
+				t.Errorf("multiply-shift32(l=%d) = 0x%x is too wide", l, h)
+			}
+			if h := a.Hash(x * 0x9e3779b9); l < 32 && h>>l != 0 {
+				t.Errorf("multiply-add-shift32(l=%d) = 0x%x is too wide", l, h)
+			}
+		}
+	}
+
+	// two fixed keys collide with probability at most 2/2^l over the choice of function
+	const l, trials = 8, 10000
+	collisions := 0
+	for seed := uint64(0); seed < trials; seed++ {
+		m := NewMultiplyShift64(seed, l)
+		if m.Hash(12345) == m.Hash(67890) {
+			collisions++
+		}
+	}
+
+	if limit := 2 * trials >> l; collisions > 2*limit {
+		t.Errorf("multiply-shift64: %d collisions in %d trials, want at most about %d", collisions, trials, limit)
+	}
+}
+
+func TestPoly61(t *testing.T) {
+
+	m := NewPoly61Seed(0)
+
+	testIncremental64(t, m, 0x1228b25fa4733ad6, "poly61")
+
+	testGolden64(t, m, goldenPoly61, "poly61")
+
+	// check the recurrence with arbitrary precision arithmetic
+	p := m.(*poly61)
+	mod := new(big.Int).SetUint64(1<<61 - 1)
+	base := new(big.Int).SetUint64(p.base)
+
+	for _, g := range goldenPoly61 {
+		want := big.NewInt(1)
+		for _, c := range []byte(g.in) {
+			want.Mul(want, base)
+			want.Add(want, big.NewInt(int64(c)))
+			want.Mod(want, mod)
+		}
+		want.Add(want, new(big.Int).SetUint64(p.b))
+		want.Mod(want, mod)
+
+		if g.out != want.Uint64() {
+			t.Errorf("poly61(%s) = 0x%016x want 0x%016x", g.in, g.out, want.Uint64())
+		}
+	}
+
+	// the easy collisions for the Java hash
+	for _, pair := range [][2]string{{"Aa", "BB"}, {"a", "\x00a"}, {"", "\x00"}} {
+		m.Reset()
+		m.Write([]byte(pair[0]))
+		h0 := m.Sum64()
+		m.Reset()
+		m.Write([]byte(pair[1]))
+		if h1 := m.Sum64(); h0 == h1 {
+			t.Errorf("poly61(%q) == poly61(%q)", pair[0], pair[1])
+		}
+	}
+
+	m1, m2 := NewPoly61(), NewPoly61()
+	m1.Write([]byte("hello"))
+	m2.Write([]byte("hello"))
+	if m1.Sum64() == m2.Sum64() {
+		t.Errorf("two instances of NewPoly61 have the same base")
+	}
+}
+
+func TestIntegerMixers(t *testing.T) {
+
+	var tests32 = []struct {
+		name     string
+		f, inv   func(uint32) uint32
+		in, want uint32
+	}{
+		{"fmix32", Fmix32, Fmix32Inverse, 0xdeadbeef, 0x0de5c6a9},
+		{"wang32", Wang32, Wang32Inverse, 0xdeadbeef, 0x92da7565},
+		{"jenkins32", JenkinsInt32, JenkinsInt32Inverse, 0xdeadbeef, 0x7ff0eada},
+		{"knuth32", Knuth32, Knuth32Inverse, 1, 2654435761},
+	}
+
+	for _, tt := range tests32 {
+		if h := tt.f(tt.in); h != tt.want {
+			t.Errorf("%s(0x%08x) = 0x%08x want 0x%08x", tt.name, tt.in, h, tt.want)
+		}
+
+		x := uint32(0)
+		for i := 0; i < 10000; i++ {
+			if y := tt.inv(tt.f(x)); y != x {
+				t.Errorf("%s inverse(%s(0x%08x)) = 0x%08x", tt.name, tt.name, x, y)
+				break
+			}
+			if y := tt.f(tt.inv(x)); y != x {
+				t.Errorf("%s(%s inverse(0x%08x)) = 0x%08x", tt.name, tt.name, x, y)
+				break
+			}
+			x = x*0x9e3779b9 + uint32(i)
+		}
+	}
+
+	var tests64 = []struct {
+		name     string
+		f, inv   func(uint64) uint64
+		in, want uint64
+	}{
+		{"fmix64", Fmix64, Fmix64Inverse, 0xdeadbeef, 0xd24bd59f862a1dac},
+		{"wang64", Wang64, Wang64Inverse, 0xdeadbeefdeadbeef, 0x4de7d5e2d3026ca4},
+		{"splitmix64", Splitmix64, Splitmix64Inverse, 0, 0xe220a8397b1dcdaf},
+		{"knuth64", Knuth64, Knuth64Inverse, 1, 0x9e3779b97f4a7c15},
+	}
+
+	for _, tt := range tests64 {
+		if h := tt.f(tt.in); h != tt.want {
+			t.Errorf("%s(0x%016x) = 0x%016x want 0x%016x", tt.name, tt.in, h, tt.want)
+		}
+
+		x := uint64(0)
+		for i := 0; i < 10000; i++ {
+			if y := tt.inv(tt.f(x)); y != x {
+				t.Errorf("%s inverse(%s(0x%016x)) = 0x%016x", tt.name, tt.name, x, y)
+				break
+			}
+			if y := tt.f(tt.inv(x)); y != x {
+				t.Errorf("%s(%s inverse(0x%016x)) = 0x%016x", tt.name, tt.name, x, y)
+				break
+			}
+			x = x*0x9e3779b97f4a7c15 + uint64(i)
+		}
+	}
+}
+
+func TestRollingHashes(t *testing.T) {
+
+	testIncremental(t, NewBuzhash32(20, 0), 0x8b78552e, "buzhash32")
+	testIncremental64(t, NewBuzhash64(20, 0), 0x25f8ca4f8b7b698a, "buzhash64")
+	testIncremental(t, NewGear32(20, 0), 0x00ec719d, "gear32")
+	testIncremental64(t, NewGear64(20, 0), 0x3b1e665900ec719d, "gear64")
+
+	var data [300]byte
+	seed := uint64(0)
+	for i := range data {
+		data[i] = byte(splitmix64(&seed))
+	}
+
+	// rolling the window along must give the same hash as hashing each window afresh
+	for _, window := range []int{1, 7, 31, 32, 33, 48, 64, 65, 100} {
+
+		var r32, f32 []RollingHash32
+		var r64, f64 []RollingHash64
+
+		r32 = append(r32, NewBuzhash32(window, 1))
+		f32 = append(f32, NewBuzhash32(window, 1))
+		r64 = append(r64, NewBuzhash64(window, 1))
+		f64 = append(f64, NewBuzhash64(window, 1))
+
+		if window <= 32 {
+			r32 = append(r32, NewGear32(window, 1))
+			f32 = append(f32, NewGear32(window, 1))
+		}
+		if window <= 64 {
+			r64 = append(r64, NewGear64(window, 1))
+			f64 = append(f64, NewGear64(window, 1))
+		}
+
+		for i, r := range r32 {
+			f := f32[i]
+			r.Write(data[:window])
+			for j := window; j < len(data); j++ {
+				r.Roll(data[j-window], data[j])
+				f.Reset()
+				f.Write(data[j-window+1 : j+1])
+				if r.Sum32() != f.Sum32() {
+					t.Errorf("%T(window %d): rolled hash at %d = 0x%08x want 0x%08x", r, window, j, r.Sum32(), f.Sum32())
+					break
+				}
+			}
+		}
+
+		for i, r := range r64 {
+			f := f64[i]
+			r.Write(data[:window])
+			for j := window; j < len(data); j++ {
+				r.Roll(data[j-window], data[j])
+				f.Reset()
+				f.Write(data[j-window+1 : j+1])
+				if r.Sum64() != f.Sum64() {
+					t.Errorf("%T(window %d): rolled hash at %d = 0x%016x want 0x%016x", r, window, j, r.Sum64(), f.Sum64())
+					break
+				}
+			}
+		}
+	}
+}
+
+func TestRollingJavaDjb(t *testing.T) {
+
+	testGolden(t, NewRollingJava32(8), goldenJava, "rolling java")
+	testGolden(t, NewRollingDjb32(8), goldenDjb32, "rolling djb")
+
+	var data [200]byte
+	seed := uint64(0)
+	for i := range data {
+		data[i] = byte(splitmix64(&seed))
+	}
+
+	for _, tt := range []struct {
+		name  string
+		roll  func(window int) RollingWindowHash32
+		fresh hash.Hash32
+	}{
+		{"java", NewRollingJava32, NewJava32()},
+		{"djb", NewRollingDjb32, NewDjb32()},
+	} {
+		for _, window := range []int{1, 2, 16, 31, 33, 100} {
+
+			r := tt.roll(window)
+			r.Write(data[:window])
+
+			for j := window; j < len(data); j++ {
+				r.Roll(data[j-window], data[j])
+				tt.fresh.Reset()
+				tt.fresh.Write(data[j-window+1 : j+1])
+				if r.Sum32() != tt.fresh.Sum32() {
+					t.Errorf("rolling %s(window %d): rolled hash at %d = 0x%08x want 0x%08x", tt.name, window, j, r.Sum32(), tt.fresh.Sum32())
+					break
+				}
+			}
+		}
+
+		// grow the window to 50 bytes, then shrink it back down to nothing
+		r := tt.roll(50)
+		for j := 0; j < 50; j++ {
+			r.Push(data[j])
+		}
+		for j := 0; j <= 50; j++ {
+			tt.fresh.Reset()
+			tt.fresh.Write(data[j:50])
+			if r.Sum32() != tt.fresh.Sum32() {
+				t.Errorf("rolling %s: hash after popping %d bytes = 0x%08x want 0x%08x", tt.name, j, r.Sum32(), tt.fresh.Sum32())
+				break
+			}
+			if j < 50 {
+				r.Pop(data[j])
+			}
+		}
+	}
+}
+
+func TestCombine(t *testing.T) {
+
+	var tests32 = []struct {
+		name    string
+		h       func() hash.Hash32
+		combine func(h1, h2 uint32, len2 int64) uint32
+	}{
+		{"java", NewJava32, CombineJava32},
+		{"djb", NewDjb32, CombineDjb32},
+		{"sdbm", NewSDBM32, CombineSDBM32},
+	}
+
+	var tests64 = []struct {
+		name    string
+		h       func() hash.Hash64
+		combine func(h1, h2 uint64, len2 int64) uint64
+	}{
+		{"java64", NewJava64, CombineJava64},
+		{"djb64", NewDjb64, CombineDjb64},
+		{"sdbm64", NewSDBM64, CombineSDBM64},
+	}
+
+	sum32 := func(h hash.Hash32, s string) uint32 { h.Reset(); h.Write([]byte(s)); return h.Sum32() }
+	sum64 := func(h hash.Hash64, s string) uint64 { h.Reset(); h.Write([]byte(s)); return h.Sum64() }
+
+	// a long second string, to exercise the repeated squaring
+	long := string(make([]byte, 10000)) + "hello"
+
+	var inputs []string
+	for _, g := range goldenJava[:12] {
+		inputs = append(inputs, g.in)
+	}
+	inputs = append(inputs, long)
+
+	for _, tt := range tests32 {
+		h := tt.h()
+		for _, a := range inputs {
+			for _, b := range inputs {
+				want := sum32(h, a+b)
+				if got := tt.combine(sum32(h, a), sum32(h, b), int64(len(b))); got != want {
+					t.Errorf("combine %s(%.10q, %.10q) = 0x%08x want 0x%08x", tt.name, a, b, got, want)
+				}
+			}
+		}
+	}
+
+	for _, tt := range tests64 {
+		h := tt.h()
+		for _, a := range inputs {
+			for _, b := range inputs {
+				want := sum64(h, a+b)
+				if got := tt.combine(sum64(h, a), sum64(h, b), int64(len(b))); got != want {
+					t.Errorf("combine %s(%.10q, %.10q) = 0x%016x want 0x%016x", tt.name, a, b, got, want)
+				}
+			}
+		}
+	}
+
+	// a negative length is rejected, as CombineAdler32 does
+	for _, tt := range tests32 {
+		if got := tt.combine(1, 2, -1); got != 0xffffffff {
+			t.Errorf("combine %s with negative length = 0x%08x want 0xffffffff", tt.name, got)
+		}
+	}
+
+	for _, tt := range tests64 {
+		if got := tt.combine(1, 2, -1); got != 0xffffffffffffffff {
+			t.Errorf("combine %s with negative length = 0x%016x want 0xffffffffffffffff", tt.name, got)
+		}
+	}
+}
+
+func TestFletcher(t *testing.T) {
+
+	testGolden(t, NewFletcher32(), goldenFletcher32, "fletcher32")
+	testGolden64(t, NewFletcher64(), goldenFletcher64, "fletcher64")
+
+	f16 := NewFletcher16()
+	for _, g := range goldenFletcher16 {
+		f16.Reset()
+		f16.Write([]byte(g.in))
+		if h := f16.Sum32(); h != g.out {
+			t.Errorf("fletcher16(%s) = 0x%04x want 0x%04x", g.in, h, g.out)
+		}
+	}
+
+	// the examples from Wikipedia
+	var vectors = []struct {
+		in       string
+		f16, f32 uint32
+		f64      uint64
+	}{
+		{"abcde", 0xc8f0, 0xf04fc729, 0xc8c6c527646362c6},
+		{"abcdef", 0x2057, 0x56502d2a, 0xc8c72b276463c8c6},
+		{"abcdefgh", 0x0627, 0xebe19591, 0x312e2b28cccac8c6},
+	}
+
+	f32, f64 := NewFletcher32(), NewFletcher64()
+
+	for _, v := range vectors {
+		f16.Reset()
+		f32.Reset()
+		f64.Reset()
+		// write a byte at a time, to exercise the partial words
+		for i := 0; i < len(v.in); i++ {
+			f16.Write([]byte{v.in[i]})
+			f32.Write([]byte{v.in[i]})
+			f64.Write([]byte{v.in[i]})
+		}
+		if f16.Sum32() != v.f16 || f32.Sum32() != v.f32 || f64.Sum64() != v.f64 {
+			t.Errorf("fletcher(%s) = 0x%04x 0x%08x 0x%016x want 0x%04x 0x%08x 0x%016x", v.in, f16.Sum32(), f32.Sum32(), f64.Sum64(), v.f16, v.f32, v.f64)
+		}
+	}
+
+	if b := f16.Sum(nil); len(b) != 2 {
+		t.Errorf("fletcher16: Sum() returned %d bytes, want 2", len(b))
+	}
+
+	// the worst case for overflow, checked against the modulus being taken at every step
+	big := make([]byte, 300000)
+	for i := range big {
+		big[i] = 0xff
+	}
+
+	for _, size := range []uint{1, 2, 4} {
+		mod := uint64(1)<<(8*size) - 1
+		var s1, s2 uint64
+		for i := 0; i < len(big); i += int(size) {
+			s1 = (s1 + mod) % mod
+			s2 = (s2 + s1) % mod
+		}
+		want := s2<<(8*size) | s1
+
+		f := newFletcher(int(size))
+		f.Write(big)
+		if h := f.Sum64(); h != want {
+			t.Errorf("fletcher%d(0xff * %d) = 0x%x want 0x%x", 16*size, len(big), h, want)
+		}
+	}
+
+	// the combine functions, splitting xxhSanityBuffer at word boundaries
+	buf := xxhSanityBuffer(1000)
+
+	for _, split := range []int{0, 4, 100, 512, 996} {
+		a, b := buf[:split], buf[split:]
+		for _, end := range []int{0, 1, 3, 250, len(b)} {
+			if end > len(b) {
+				continue
+			}
+			b := b[:end]
+
+			h := func(f hash.Hash64, p []byte) uint64 { f.Reset(); f.Write(p); return f.Sum64() }
+			ab := append(append([]byte{}, a...), b...)
+
+			for _, size := range []int{1, 2, 4} {
+				f := newFletcher(size)
+				want := h(f, ab)
+				var got uint64
+				switch size {
+				case 1:
+					got = uint64(CombineFletcher16(uint32(h(f, a)), uint32(h(f, b)), int64(len(b))))
+				case 2:
+					got = uint64(CombineFletcher32(uint32(h(f, a)), uint32(h(f, b)), int64(len(b))))
+				case 4:
+					got = CombineFletcher64(h(f, a), h(f, b), int64(len(b)))
+				}
+				if got != want {
+					t.Errorf("combine fletcher%d(%d, %d) = 0x%x want 0x%x", 16*size, len(a), len(b), got, want)
+				}
+			}
+
+			ad := NewAdler32()
+			ad.Write(ab)
+			want := ad.Sum32()
+			ad.Reset()
+			ad.Write(a)
+			h1 := ad.Sum32()
+			ad.Reset()
+			ad.Write(b)
+			if got := CombineAdler32(h1, ad.Sum32(), int64(len(b))); got != want {
+				t.Errorf("combine adler32(%d, %d) = 0x%08x want 0x%08x", len(a), len(b), got, want)
+			}
+		}
+	}
+
+	// zlib's adler32_combine() returns 0xffffffff for a negative length
+	if got := CombineAdler32(1, 1, -1); got != 0xffffffff {
+		t.Errorf("combine adler32 with negative length = 0x%08x want 0xffffffff", got)
+	}
+
+	// and so do the Fletcher combine functions, which can never return all ones otherwise
+	for _, len2 := range []int64{-1, -2, -5} {
+		if got := CombineFletcher16(1, 1, len2); got != 0xffffffff {
+			t.Errorf("combine fletcher16 with length %d = 0x%08x want 0xffffffff", len2, got)
+		}
+		if got := CombineFletcher32(1, 1, len2); got != 0xffffffff {
+			t.Errorf("combine fletcher32 with length %d = 0x%08x want 0xffffffff", len2, got)
+		}
+		if got := CombineFletcher64(1, 1, len2); got != 0xffffffffffffffff {
+			t.Errorf("combine fletcher64 with length %d = 0x%016x want 0xffffffffffffffff", len2, got)
+		}
+	}
+
+	// the partial words are kept internally, so any number of bytes can be written at a time
+	for _, h := range []hash.Hash{NewFletcher16(), NewFletcher32(), NewFletcher64()} {
+		if bs := h.BlockSize(); bs != 1 {
+			t.Errorf("fletcher%d BlockSize() = %d want 1", 8*h.Size(), bs)
+		}
+	}
+}
+
+func TestUnixSum(t *testing.T) {
+
+	bsd, sysv := NewBSDSum(), NewSysVSum()
+
+	// these agree with GNU coreutils sum -r and sum -s
+	for i, g := range goldenBSDSum {
+		bsd.Reset()
+		bsd.Write([]byte(g.in))
+		if h := bsd.Sum32(); h != g.out {
+			t.Errorf("bsd sum(%s) = %d want %d", g.in, h, g.out)
+		}
+
+		s := goldenSysVSum[i]
+		sysv.Reset()
+		sysv.Write([]byte(s.in))
+		if h := sysv.Sum32(); h != s.out {
+			t.Errorf("sysv sum(%s) = %d want %d", s.in, h, s.out)
+		}
+	}
+
+	buf := xxhSanityBuffer(100000)
+
+	for _, split := range []int{0, 1, 777, 65535, 100000} {
+		sysv.Reset()
+		sysv.Write(buf)
+		want := sysv.Sum32()
+		sysv.Reset()
+		sysv.Write(buf[:split])
+		h1 := sysv.Sum32()
+		sysv.Reset()
+		sysv.Write(buf[split:])
+		if got := CombineSysVSum(h1, sysv.Sum32()); got != want {
+			t.Errorf("combine sysv sum(%d) = %d want %d", split, got, want)
+		}
+	}
+}
+
+func TestRegistry(t *testing.T) {
+
+	for _, name := range List() {
+		var opts []Option
+		if strings.HasPrefix(name, "buzhash") || strings.HasPrefix(name, "gear") || strings.HasPrefix(name, "rolling_") {
+			opts = append(opts, WithWindow(16))
+		}
+		h, err := New(name, opts...)
+		if err != nil {
+			t.Errorf("New(%q) failed: %v", name, err)
+			continue
+		}
+		h.Write([]byte("hello, world"))
+		if sum := h.Sum(nil); len(sum) != h.Size() {
+			t.Errorf("%s: len(Sum()) = %d want %d", name, len(sum), h.Size())
+		}
+	}
+
+	for _, name := range []string{"murmur3_x86_32", "sdbm", "marvin32"} {
+		if _, err := New(name); err != nil {
+			t.Errorf("New(%q) failed: %v", name, err)
+		}
+	}
+
+	h, err := New("murmur3_x86_32", WithSeed(0x9747b28c))
+	if err != nil {
+		t.Fatalf("New(murmur3_x86_32) failed: %v", err)
+	}
+	m := NewMurmur3_x86_32Seed(0x9747b28c)
+	h.Write([]byte("The quick brown fox jumps over the lazy dog"))
+	m.Write([]byte("The quick brown fox jumps over the lazy dog"))
+	if got, want := h.(hash.Hash32).Sum32(), m.Sum32(); got != want {
+		t.Errorf("seeded murmur3_x86_32 = 0x%08x want 0x%08x", got, want)
+	}
+
+	errs := []struct {
+		name string
+		opts []Option
+	}{
+		{"nosuchhash", nil},
+		{"sdbm", []Option{WithSeed(1)}},
+		{"murmur3_x86_32", []Option{WithSeed(1 << 32)}},
+		{"siphash24", []Option{WithSeeds(1, 2, 3)}},
+		{"buzhash32", nil},
+		{"xxh64", []Option{WithWindow(8)}},
+		{"gear32", []Option{WithWindow(33)}},
+		{"gear64", []Option{WithWindow(65)}},
+		{"rolling_java", []Option{WithWindow(4), WithSeed(5)}},
+		{"rolling_djb2", []Option{WithWindow(4), WithSeed(5)}},
+	}
+
+	for _, e := range errs {
+		if _, err := New(e.name, e.opts...); err == nil {
+			t.Errorf("New(%q) succeeded, expected an error", e.name)
+		}
+	}
+
+	// the largest windows Gear supports are fine
+	for _, g := range []struct {
+		name   string
+		window int
+	}{{"gear32", 32}, {"gear64", 64}} {
+		if _, err := New(g.name, WithWindow(g.window)); err != nil {
+			t.Errorf("New(%q, WithWindow(%d)) failed: %v", g.name, g.window, err)
+		}
+	}
+
+	// the rolling Java and djb2 hashes reject a seed as the other unseeded hashes do
+	for _, name := range []string{"rolling_java", "rolling_djb2"} {
+		_, err := New(name, WithWindow(4), WithSeed(5))
+		if err == nil || !strings.HasSuffix(err.Error(), errNoSeed.Error()) {
+			t.Errorf("New(%q, WithSeed(5)) = %v, want %q", name, err, errNoSeed)
+		}
+	}
+
+	Register("test_registry_java", func(o Options) (hash.Hash, error) { return NewJava32(), nil })
+	if h, err := New("test_registry_java"); err != nil || h.Size() != 4 {
+		t.Errorf("New(test_registry_java) = %v, %v", h, err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("registering a duplicate name didn't panic")
+		}
+	}()
+	Register("sdbm", func(o Options) (hash.Hash, error) { return NewSDBM32(), nil })
+}
+
+func BenchmarkJava32(b *testing.B) {
+	commonBench(b, NewJava32(), goldenJava)
+}
+
+func BenchmarkJava64(b *testing.B) {
+	commonBench64(b, NewJava64(), goldenJava64)
+}
+
+func BenchmarkDJB(b *testing.B) {
+	commonBench(b, NewDjb32(), goldenDjb32)
+}
+
+func BenchmarkDJB64(b *testing.B) {
+	commonBench64(b, NewDjb64(), goldenDjb64)
+}
+
+func BenchmarkDJB64a(b *testing.B) {
+	commonBench64(b, NewDjb64a(), goldenDjb64a)
+}
+
+func BenchmarkElf32(b *testing.B) {
+	commonBench(b, NewElf32(), goldenElf32)
+}
+
+func BenchmarkJenkins32(b *testing.B) {
+	commonBench(b, NewJenkins32(), goldenJenkins)
+}
+
+func BenchmarkMarvin32(b *testing.B) {
+	commonBench(b, NewMarvin32(0), goldenMarvin)
+}
+
+func BenchmarkMurmur(b *testing.B) {
+	commonBench(b, NewMurmur3_x86_32(), goldenMurmur3)
+}
+
+func BenchmarkMurmur3_x86_128(b *testing.B) {
+	commonBench128(b, NewMurmur3_x86_128(), goldenMurmur3_x86_128)
+}
+
+func BenchmarkMurmur3_x64_128(b *testing.B) {
+	commonBench128(b, NewMurmur3_x64_128(), goldenMurmur3_x64_128)
+}
+
+func BenchmarkSDBM32(b *testing.B) {
+	commonBench(b, NewSDBM32(), goldenSdbm)
+}
+
+func BenchmarkSDBM64(b *testing.B) {
+	commonBench64(b, NewSDBM64(), goldenSdbm64)
+}
+
+func BenchmarkSQLite32(b *testing.B) {
+	commonBench(b, NewSQLite32(), goldenSqlite)
+}
+
+func BenchmarkRS(b *testing.B) {
+	commonBench(b, NewRS32(), goldenRS)
+}
+
+func BenchmarkJS(b *testing.B) {
+	commonBench(b, NewJS32(), goldenJS)
+}
+
+func BenchmarkPJW(b *testing.B) {
+	commonBench(b, NewPJW32(), goldenPJW)
+}
+
+func BenchmarkBKDR(b *testing.B) {
+	commonBench(b, NewBKDR32(), goldenBKDR)
+}
+
+func BenchmarkDEK(b *testing.B) {
+	commonBench(b, NewDEK32(), goldenDEK)
+}
+
+func BenchmarkBP(b *testing.B) {
+	commonBench(b, NewBP32(), goldenBP)
+}
+
+func BenchmarkAP(b *testing.B) {
+	commonBench(b, NewAP32(), goldenAP)
+}
+
+func BenchmarkKR1(b *testing.B) {
+	commonBench(b, NewKR1_32(), goldenKR1)
+}
+
+func BenchmarkKR2(b *testing.B) {
+	commonBench(b, NewKR2_32(), goldenKR2)
+}
+
+func BenchmarkSuperFastHash(b *testing.B) {
+	commonBench(b, NewSuperFastHash(), goldenSuperfast)
+}
+
+func BenchmarkSuperFastHashLength(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, g := range goldenSuperFastHash {
+			SuperFastHash([]byte(g.in))
+		}
+	}
+}
+
+func BenchmarkMurmur2(b *testing.B) {
+	commonBench(b, NewMurmur2(0), goldenMurmur2)
+}
+
+func BenchmarkMurmur2A(b *testing.B) {
+	commonBench(b, NewMurmur2A(0), goldenMurmur2A)
+}
+
+func BenchmarkMurmur64A(b *testing.B) {
+	commonBench64(b, NewMurmur64A(0), goldenMurmur64A)
+}
+
+func BenchmarkMurmur64B(b *testing.B) {
+	commonBench64(b, NewMurmur64B(0), goldenMurmur64B)
+}
+
+func BenchmarkXXH32(b *testing.B) {
+	commonBench(b, NewXXH32(0), goldenXXH32)
+}
+
+func BenchmarkXXH64(b *testing.B) {
+	commonBench64(b, NewXXH64(0), goldenXXH64)
+}
+
+func BenchmarkXXH3_64(b *testing.B) {
+	commonBench64(b, NewXXH3_64(), goldenXXH3_64)
+}
+
+func BenchmarkXXH3_128(b *testing.B) {
+	commonBench128(b, NewXXH3_128(), goldenXXH3_128)
+}
+
+func BenchmarkSipHash24(b *testing.B) {
+	commonBench64(b, NewSipHash24(sipK0, sipK1), goldenSipHash24)
+}
+
+func BenchmarkSipHash13(b *testing.B) {
+	commonBench64(b, NewSipHash13(sipK0, sipK1), goldenSipHash13)
+}
+
+func BenchmarkSipHash24_128(b *testing.B) {
+	commonBench128(b, NewSipHash24_128(sipK0, sipK1), goldenSipHash24_128)
+}
+
+func BenchmarkHighwayHash64(b *testing.B) {
+	commonBench64(b, NewHighwayHash64(highwayKey), goldenHighwayHash64)
+}
+
+func BenchmarkHighwayHash128(b *testing.B) {
+	commonBench128(b, NewHighwayHash128(highwayKey), goldenHighwayHash128)
+}
+
+func BenchmarkCityHash32(b *testing.B) {
+	commonBench(b, NewCityHash32(), goldenCityHash32)
+}
+
+func BenchmarkCityHash64(b *testing.B) {
+	commonBench64(b, NewCityHash64(), goldenCityHash64)
+}
+
+func BenchmarkCityHash128(b *testing.B) {
+	commonBench128(b, NewCityHash128(), goldenCityHash128)
+}
+
+func BenchmarkFarmFingerprint32(b *testing.B) {
+	commonBench(b, NewFarmFingerprint32(), goldenFarmFingerprint32)
+}
+
+func BenchmarkFarmFingerprint64(b *testing.B) {
+	commonBench64(b, NewFarmFingerprint64(), goldenFarmFingerprint64)
+}
+
+func BenchmarkSpookyHash(b *testing.B) {
+	commonBench128(b, NewSpookyHash(0, 0), goldenSpookyHash128)
+}
+
+func BenchmarkJenkinsLookup2(b *testing.B) {
+	commonBench(b, NewJenkinsLookup2(0), goldenJenkinsLookup2)
+}
+
+func BenchmarkJenkinsHashLittle(b *testing.B) {
+	commonBench(b, NewJenkinsHashLittle(0), goldenJenkinsHashLittle)
+}
+
+func BenchmarkPearson8(b *testing.B) {
+	commonBench(b, NewPearson8(PearsonTable(0)), goldenPearson8)
+}
+
+func BenchmarkPearson64(b *testing.B) {
+	commonBench64(b, NewPearson64(PearsonTable(0)), goldenPearson64)
+}
+
+func BenchmarkWyHash(b *testing.B) {
+	commonBench64(b, NewWyHash(0), goldenWyHash)
+}
+
+func BenchmarkRapidHash(b *testing.B) {
+	commonBench64(b, NewRapidHash(0), goldenRapidHash)
+}
+
+func BenchmarkMetroHash64_1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, g := range goldenMetroHash64_1 {
+			MetroHash64_1([]byte(g.in), 0)
+		}
+	}
+}
+
+func BenchmarkMetroHash128_1(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, g := range goldenMetroHash128_1 {
+			MetroHash128_1([]byte(g.in), 0)
+		}
+	}
+}
+
+func BenchmarkTabulation64(b *testing.B) {
+	h := NewTabulation64(0)
+	for i := 0; i < b.N; i++ {
+		h.Hash(uint64(i))
+	}
+}
+
+func BenchmarkTwistedTabulation64(b *testing.B) {
+	h := NewTwistedTabulation64(0)
+	for i := 0; i < b.N; i++ {
+		h.Hash(uint64(i))
+	}
+}
+
+func BenchmarkMultiplyAddShift64(b *testing.B) {
+	h := NewMultiplyAddShift64(0, 64)
+	for i := 0; i < b.N; i++ {
+		h.Hash(uint64(i))
+	}
+}
+
+func BenchmarkPoly61(b *testing.B) {
+	commonBench64(b, NewPoly61Seed(0), goldenPoly61)
+}
+
+func BenchmarkBuzhash64Roll(b *testing.B) {
+	h := NewBuzhash64(64, 0)
+	for i := 0; i < b.N; i++ {
+		h.Roll(byte(i-64), byte(i))
+	}
+}
+
+func BenchmarkGear64Roll(b *testing.B) {
+	h := NewGear64(64, 0)
+	for i := 0; i < b.N; i++ {
+		h.Roll(byte(i-64), byte(i))
+	}
+}
+
+func BenchmarkFletcher32(b *testing.B) {
+	commonBench(b, NewFletcher32(), goldenFletcher32)
+}
+
+func BenchmarkFletcher64(b *testing.B) {
+	commonBench64(b, NewFletcher64(), goldenFletcher64)
+}
+
+func commonBench(b *testing.B, h hash.Hash32, golden []_Golden) {
+	for i := 0; i < b.N; i++ {
+		for _, g := range golden {
+			h.Reset()
+			h.Write([]byte(g.in))
+			h.Sum32()
+		}
+	}
+}
+
+func commonBench64(b *testing.B, h hash.Hash64, golden []_Golden64) {
+	for i := 0; i < b.N; i++ {
+		for _, g := range golden {
+			h.Reset()
+			h.Write([]byte(g.in))
+			h.Sum64()
+		}
+	}
+}
+
+func commonBench128(b *testing.B, h Hash128, golden []_Golden128) {
+	for i := 0; i < b.N; i++ {
+		for _, g := range golden {
+			h.Reset()
+			h.Write([]byte(g.in))
+			h.Sum128()
+		}
+	}
+}
+
+// smhasherVerification computes the value of SMHasher's VerificationTest.
+// f must return the hash of key with the given seed as little-endian bytes,
+// which is how the reference implementations write their output.
+func smhasherVerification(f func(key []byte, seed uint32) []byte) uint32 {
+
+	var key [256]byte
+	var hashes []byte
+
+	for i := range key {
+		key[i] = byte(i)
+		hashes = append(hashes, f(key[:i], uint32(256-i))...)
+	}
+
+	final := f(hashes, 0)
+
+	return binary.LittleEndian.Uint32(final)
+}
+
+// little-endian bytes of v, for smhasherVerification
+func le32(v uint32) []byte {
+	p := make([]byte, 4)
+	binary.LittleEndian.PutUint32(p, v)
+	return p
+}
+
+func le64(v uint64) []byte {
+	p := make([]byte, 8)
+	binary.LittleEndian.PutUint64(p, v)
+	return p
+}
+
+// xxhSanityBuffer returns the pseudo-random test buffer used by xxhsum's sanity checks
+func xxhSanityBuffer(n int) []byte {
+
+	const prime32 = 2654435761
+	const prime64 = 11400714785074694797
+
+	p := make([]byte, n)
+
+	byteGen := uint64(prime32)
+
+	for i := range p {
+		p[i] = byte(byteGen >> 56)
+		byteGen *= prime64
+	}
+
+	return p
+}
+
+func testIncremental(t *testing.T, h hash.Hash32, result uint32, which string) {
+
+	h.Reset()
+
+	var parts = []string{
+		"h",
+		"ell",
+		"o",
+		"he",
+		"llo",
+		"hellohello",
+	}
+
+	for _, p := range parts {
+		l, _ := h.Write([]byte(p))
+		if l != len(p) {
+			t.Errorf("Write(%d bytes) = %d, want %d\n", len(p), l, len(p))
+		}
+	}
+
+	h32 := h.Sum32()
+
+	if h32 != result {
+		t.Errorf("%s: incremental failed: got %08x", which, h32)
+	}
+
+	h.Reset()
+	h.Write([]byte("hellohellohellohello"))
+
+	h32 = h.Sum32()
+
+	if h32 != result {
+		t.Errorf("%s: failed: got %08x", which, h32)
+	}
+}
+
+func testGolden(t *testing.T, h hash.Hash32, golden []_Golden, which string) {
+
+	for _, g := range golden {
+		h.Reset()
+		h.Write([]byte(g.in))
+
+		sum := h.Sum32()
+
+		if sum != g.out {
+			t.Errorf("%s(%s) = 0x%x want 0x%x", which, g.in, sum, g.out)
+		}
+
+		bsum := h.Sum(nil)
+
+		if len(bsum) != 4 {
+			t.Errorf("%s Sum(nil) returned %d bytes, wanted 4: %s\n", which, len(bsum), bsum)
+		}
+
+		s := binary.BigEndian.Uint32(bsum)
+
+		if s != sum {
+			t.Errorf("%s(%s).Sum(nil) = 0x%x want 0x%x", which, g.in, sum, g.out)
+		}
+
+		bsum = h.Sum([]byte{0x01, 0x02, 0x03, 0x04})
+
+		if len(bsum) != 8 {
+			t.Errorf("%s Sum(bsum) returned %d bytes, wanted 8: %x\n", which, len(bsum), bsum)
+		}
+
+		s = binary.BigEndian.Uint32(bsum[0:])
+		s2 := binary.BigEndian.Uint32(bsum[4:])
 
 		if s != 0x01020304 || s2 != sum {
 			t.Errorf("%s(%s).Sum(bsum) = %x (expected 0x01020304 %x )", which, g.in, bsum, sum)
@@ -538,3 +5430,227 @@ func testGolden(t *testing.T, h hash.Hash32, golden []_Golden, which string) {
 
 	}
 }
+
+func testIncremental64(t *testing.T, h hash.Hash64, result uint64, which string) {
+
+	h.Reset()
+
+	var parts = []string{
+		"h",
+		"ell",
+		"o",
+		"he",
+		"llo",
+		"hellohello",
+	}
+
+	for _, p := range parts {
+		l, _ := h.Write([]byte(p))
+		if l != len(p) {
+			t.Errorf("Write(%d bytes) = %d, want %d\n", len(p), l, len(p))
+		}
+	}
+
+	h64 := h.Sum64()
+
+	if h64 != result {
+		t.Errorf("%s: incremental failed: got %016x", which, h64)
+	}
+
+	h.Reset()
+	h.Write([]byte("hellohellohellohello"))
+
+	h64 = h.Sum64()
+
+	if h64 != result {
+		t.Errorf("%s: failed: got %016x", which, h64)
+	}
+}
+
+func testGolden64(t *testing.T, h hash.Hash64, golden []_Golden64, which string) {
+
+	for _, g := range golden {
+		h.Reset()
+		h.Write([]byte(g.in))
+
+		sum := h.Sum64()
+
+		if sum != g.out {
+			t.Errorf("%s(%s) = 0x%x want 0x%x", which, g.in, sum, g.out)
+		}
+
+		bsum := h.Sum(nil)
+
+		if len(bsum) != 8 {
+			t.Errorf("%s Sum(nil) returned %d bytes, wanted 8: %s\n", which, len(bsum), bsum)
+		}
+
+		s := binary.BigEndian.Uint64(bsum)
+
+		if s != sum {
+			t.Errorf("%s(%s).Sum(nil) = 0x%x want 0x%x", which, g.in, sum, g.out)
+		}
+
+		bsum = h.Sum([]byte{0x01, 0x02, 0x03, 0x04})
+
+		if len(bsum) != 12 {
+			t.Errorf("%s Sum(bsum) returned %d bytes, wanted 12: %x\n", which, len(bsum), bsum)
+		}
+
+		s1 := binary.BigEndian.Uint32(bsum[0:])
+		s2 := binary.BigEndian.Uint64(bsum[4:])
+
+		if s1 != 0x01020304 || s2 != sum {
+			t.Errorf("%s(%s).Sum(bsum) = %x (expected 0x01020304 %x )", which, g.in, bsum, sum)
+		}
+	}
+}
+
+func testIncremental128(t *testing.T, h Hash128, r1, r2 uint64, which string) {
+
+	h.Reset()
+
+	var parts = []string{
+		"h",
+		"ell",
+		"o",
+		"he",
+		"llo",
+		"hellohello",
+	}
+
+	for _, p := range parts {
+		l, _ := h.Write([]byte(p))
+		if l != len(p) {
+			t.Errorf("Write(%d bytes) = %d, want %d\n", len(p), l, len(p))
+		}
+	}
+
+	h1, h2 := h.Sum128()
+
+	if h1 != r1 || h2 != r2 {
+		t.Errorf("%s: incremental failed: got %016x %016x", which, h1, h2)
+	}
+
+	h.Reset()
+	h.Write([]byte("hellohellohellohello"))
+
+	h1, h2 = h.Sum128()
+
+	if h1 != r1 || h2 != r2 {
+		t.Errorf("%s: failed: got %016x %016x", which, h1, h2)
+	}
+}
+
+func testGolden128(t *testing.T, h Hash128, golden []_Golden128, which string) {
+
+	for _, g := range golden {
+		h.Reset()
+		h.Write([]byte(g.in))
+
+		h1, h2 := h.Sum128()
+
+		if h1 != g.h1 || h2 != g.h2 {
+			t.Errorf("%s(%s) = 0x%016x 0x%016x want 0x%016x 0x%016x", which, g.in, h1, h2, g.h1, g.h2)
+		}
+
+		if s := h.Sum64(); s != h1 {
+			t.Errorf("%s(%s).Sum64() = 0x%016x want 0x%016x", which, g.in, s, h1)
+		}
+
+		bsum := h.Sum([]byte{0x01, 0x02, 0x03, 0x04})
+
+		if len(bsum) != 20 {
+			t.Errorf("%s Sum(bsum) returned %d bytes, wanted 20: %x\n", which, len(bsum), bsum)
+		}
+
+		s := binary.BigEndian.Uint32(bsum[0:])
+		s1 := binary.BigEndian.Uint64(bsum[4:])
+		s2 := binary.BigEndian.Uint64(bsum[12:])
+
+		if s != 0x01020304 || s1 != h1 || s2 != h2 {
+			t.Errorf("%s(%s).Sum(bsum) = %x (expected 0x01020304 %016x %016x)", which, g.in, bsum, h1, h2)
+		}
+	}
+}
+
+func testIncremental256(t *testing.T, h Hash256, r [4]uint64, which string) {
+
+	h.Reset()
+
+	var parts = []string{
+		"h",
+		"ell",
+		"o",
+		"he",
+		"llo",
+		"hellohello",
+	}
+
+	for _, p := range parts {
+		l, _ := h.Write([]byte(p))
+		if l != len(p) {
+			t.Errorf("Write(%d bytes) = %d, want %d\n", len(p), l, len(p))
+		}
+	}
+
+	if h1, h2, h3, h4 := h.Sum256(); [4]uint64{h1, h2, h3, h4} != r {
+		t.Errorf("%s: incremental failed: got %016x", which, [4]uint64{h1, h2, h3, h4})
+	}
+
+	h.Reset()
+	h.Write([]byte("hellohellohellohello"))
+
+	if h1, h2, h3, h4 := h.Sum256(); [4]uint64{h1, h2, h3, h4} != r {
+		t.Errorf("%s: failed: got %016x", which, [4]uint64{h1, h2, h3, h4})
+	}
+}
+
+func testGolden256(t *testing.T, h Hash256, golden []_Golden256, which string) {
+
+	for _, g := range golden {
+		h.Reset()
+		h.Write([]byte(g.in))
+
+		h1, h2, h3, h4 := h.Sum256()
+
+		if h1 != g.h1 || h2 != g.h2 || h3 != g.h3 || h4 != g.h4 {
+			t.Errorf("%s(%s) = 0x%016x 0x%016x 0x%016x 0x%016x want 0x%016x 0x%016x 0x%016x 0x%016x", which, g.in, h1, h2, h3, h4, g.h1, g.h2, g.h3, g.h4)
+		}
+
+		if s := h.Sum64(); s != h1 {
+			t.Errorf("%s(%s).Sum64() = 0x%016x want 0x%016x", which, g.in, s, h1)
+		}
+
+		bsum := h.Sum([]byte{0x01, 0x02, 0x03, 0x04})
+
+		if len(bsum) != 36 {
+			t.Errorf("%s Sum(bsum) returned %d bytes, wanted 36: %x\n", which, len(bsum), bsum)
+		}
+
+		s := binary.BigEndian.Uint32(bsum[0:])
+		s1 := binary.BigEndian.Uint64(bsum[4:])
+		s2 := binary.BigEndian.Uint64(bsum[12:])
+		s3 := binary.BigEndian.Uint64(bsum[20:])
+		s4 := binary.BigEndian.Uint64(bsum[28:])
+
+		if s != 0x01020304 || s1 != h1 || s2 != h2 || s3 != h3 || s4 != h4 {
+			t.Errorf("%s(%s).Sum(bsum) = %x (expected 0x01020304 %016x %016x %016x %016x)", which, g.in, bsum, h1, h2, h3, h4)
+		}
+	}
+}
+
+// cityTestData returns the pseudo-random buffer used by the CityHash and FarmHash reference tests
+func cityTestData() []byte {
+	data := make([]byte, 1<<20)
+	a := uint64(9)
+	b := uint64(777)
+	for i := range data {
+		a += b
+		b += a
+		a = (a ^ (a >> 41)) * 0xc3a5c85c97cb3127
+		b = (b^(b>>41))*0xc3a5c85c97cb3127 + uint64(i)
+		data[i] = byte(b >> 37)
+	}
+	return data
+}
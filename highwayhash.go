@@ -0,0 +1,247 @@
+// This file is an implementation of the HighwayHash keyed hash function by Jyrki Alakuijala, Bill Cox and Jan Wassenberg
+// The code is translated from the Apache-licensed portable C source code at https://github.com/google/highwayhash
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// HighwayHash takes a 256-bit key, given here as four little-endian uint64
+// words, and produces 64, 128 or 256 bits of output.  The 64-bit variant is
+// not simply a truncation of the longer ones: each output size runs a
+// different number of finalization rounds.
+
+package dgohash
+
+import (
+	"hash"
+)
+
+// Hash256 is the common interface implemented by the 256-bit hashes
+type Hash256 interface {
+	hash.Hash64
+	// Sum256 returns the 256-bit hash as four 64-bit words.  Sum64 returns the first of them.
+	Sum256() (uint64, uint64, uint64, uint64)
+}
+
+var highwayInit0 = [4]uint64{0xdbe6d5d5fe4cce2f, 0xa4093822299f31d0, 0x13198a2e03707344, 0x243f6a8885a308d3}
+var highwayInit1 = [4]uint64{0x3bd39e10cb0ef593, 0xc0acf169b5f18a8c, 0xbe5466cf34e90c6c, 0x452821e638d01377}
+
+type highwayhash struct {
+	key        [4]uint64
+	size       int       // output size in bytes, 8, 16 or 32
+	v0, v1     [4]uint64 // our hash state
+	mul0, mul1 [4]uint64
+	t          [32]byte // as-yet-unprocessed bytes
+	rem        int      // how many bytes in t[] are valid
+}
+
+func newHighwayHash(key [4]uint64, size int) *highwayhash {
+	h := &highwayhash{key: key, size: size}
+	h.Reset()
+	return h
+}
+
+// NewHighwayHash64 returns a new hash.Hash64 object computing HighwayHash-64 with the given key
+func NewHighwayHash64(key [4]uint64) hash.Hash64 {
+	return newHighwayHash(key, 8)
+}
+
+// NewHighwayHash128 returns a new Hash128 object computing HighwayHash-128 with the given key
+func NewHighwayHash128(key [4]uint64) Hash128 {
+	return newHighwayHash(key, 16)
+}
+
+// NewHighwayHash256 returns a new Hash256 object computing HighwayHash-256 with the given key
+func NewHighwayHash256(key [4]uint64) Hash256 {
+	return newHighwayHash(key, 32)
+}
+
+func (h *highwayhash) Size() int      { return h.size }
+func (h *highwayhash) BlockSize() int { return 32 }
+func (h *highwayhash) Reset() {
+	h.mul0 = highwayInit0
+	h.mul1 = highwayInit1
+	for i, k := range h.key {
+		h.v0[i] = h.mul0[i] ^ k
+		h.v1[i] = h.mul1[i] ^ (k>>32 | k<<32)
+	}
+	h.rem = 0
+}
+
+// the ZipperMergeAndAdd() function from the reference code
+func zipperMergeAndAdd(v1, v0 uint64, add1, add0 *uint64) {
+	*add0 += (((v0 & 0xff000000) | (v1 & 0xff00000000)) >> 24) |
+		(((v0 & 0xff0000000000) | (v1 & 0xff000000000000)) >> 16) |
+		(v0 & 0xff0000) | ((v0 & 0xff00) << 32) |
+		((v1 & 0xff00000000000000) >> 8) | (v0 << 56)
+	*add1 += (((v1 & 0xff000000) | (v0 & 0xff00000000)) >> 24) |
+		(v1 & 0xff0000) | ((v1 & 0xff0000000000) >> 16) |
+		((v1 & 0xff00) << 24) | ((v0 & 0xff000000000000) >> 8) |
+		((v1 & 0xff) << 48) | (v0 & 0xff00000000000000)
+}
+
+// computes new hash state merged with the four message lanes
+func (h *highwayhash) updateLanes(lanes *[4]uint64) {
+	for i := 0; i < 4; i++ {
+		h.v1[i] += h.mul0[i] + lanes[i]
+		h.mul0[i] ^= (h.v1[i] & 0xffffffff) * (h.v0[i] >> 32)
+		h.v0[i] += h.mul1[i]
+		h.mul1[i] ^= (h.v0[i] & 0xffffffff) * (h.v1[i] >> 32)
+	}
+	zipperMergeAndAdd(h.v1[1], h.v1[0], &h.v0[1], &h.v0[0])
+	zipperMergeAndAdd(h.v1[3], h.v1[2], &h.v0[3], &h.v0[2])
+	zipperMergeAndAdd(h.v0[1], h.v0[0], &h.v1[1], &h.v1[0])
+	zipperMergeAndAdd(h.v0[3], h.v0[2], &h.v1[3], &h.v1[2])
+}
+
+// computes new hash state merged with the 32-byte packet in p
+func (h *highwayhash) update(p []byte) {
+	lanes := [4]uint64{readLE64(p[0:]), readLE64(p[8:]), readLE64(p[16:]), readLE64(p[24:])}
+	h.updateLanes(&lanes)
+}
+
+func (h *highwayhash) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+
+	// Keep track of the bytes that don't yet make up a full packet, and
+	// process them on the next round if we can scrounge together enough.
+	// If they're not merged here, they're pulled in during the finalize step
+	if h.rem != 0 {
+
+		n := copy(h.t[h.rem:], data)
+		h.rem += n
+
+		if h.rem < 32 {
+			return datalen, nil
+		}
+
+		h.update(h.t[:])
+
+		// nothing is left in the tail
+		h.rem = 0
+		data = data[n:]
+	}
+
+	length := len(data)
+
+	// figure out the length of the tail, and round down b
+	rem := length & 31
+	b := length - rem
+
+	for i := 0; i < b; i += 32 {
+		h.update(data[i:])
+	}
+
+	// copy the tail for later
+	copy(h.t[:rem], data[b:])
+
+	h.rem = rem
+
+	return datalen, nil
+}
+
+// the HighwayHashUpdateRemainder() function from the reference code
+func (h *highwayhash) updateRemainder() {
+
+	size := uint64(h.rem)
+
+	for i := 0; i < 4; i++ {
+		h.v0[i] += size<<32 + size
+	}
+
+	// rotate each 32-bit half of v1 left by size
+	for i, v := range h.v1 {
+		half0 := rotl32(uint32(v), uint8(size))
+		half1 := rotl32(uint32(v>>32), uint8(size))
+		h.v1[i] = uint64(half1)<<32 | uint64(half0)
+	}
+
+	var packet [32]byte
+
+	mod4 := h.rem & 3
+	b := h.rem &^ 3
+
+	copy(packet[:], h.t[:b])
+
+	if h.rem&16 != 0 {
+		copy(packet[28:], h.t[b+mod4-4:b+mod4])
+	} else if mod4 != 0 {
+		packet[16] = h.t[b]
+		packet[17] = h.t[b+mod4>>1]
+		packet[18] = h.t[b+mod4-1]
+	}
+
+	h.update(packet[:])
+}
+
+// highwayhash finalize step, shared between all output sizes
+func (h *highwayhash) finalize(rounds int) *highwayhash {
+
+	// copy so as not to change the internal state
+	tmp := *h
+
+	if tmp.rem != 0 {
+		tmp.updateRemainder()
+	}
+
+	for i := 0; i < rounds; i++ {
+		permuted := [4]uint64{
+			tmp.v0[2]>>32 | tmp.v0[2]<<32,
+			tmp.v0[3]>>32 | tmp.v0[3]<<32,
+			tmp.v0[0]>>32 | tmp.v0[0]<<32,
+			tmp.v0[1]>>32 | tmp.v0[1]<<32,
+		}
+		tmp.updateLanes(&permuted)
+	}
+
+	return &tmp
+}
+
+func (h *highwayhash) Sum(b []byte) []byte {
+	switch h.size {
+	case 16:
+		h1, h2 := h.Sum128()
+		return appendSum128(b, h1, h2)
+	case 32:
+		h1, h2, h3, h4 := h.Sum256()
+		return appendSum128(appendSum128(b, h1, h2), h3, h4)
+	}
+	v := h.Sum64()
+	return append(b, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+// Sum64 returns the 64-bit hash, or the first 64 bits of the longer ones
+func (h *highwayhash) Sum64() uint64 {
+	switch h.size {
+	case 16:
+		h1, _ := h.Sum128()
+		return h1
+	case 32:
+		h1, _, _, _ := h.Sum256()
+		return h1
+	}
+
+	tmp := h.finalize(4)
+	return tmp.v0[0] + tmp.v1[0] + tmp.mul0[0] + tmp.mul1[0]
+}
+
+func (h *highwayhash) Sum128() (uint64, uint64) {
+	tmp := h.finalize(6)
+	h1 := tmp.v0[0] + tmp.mul0[0] + tmp.v1[2] + tmp.mul1[2]
+	h2 := tmp.v0[1] + tmp.mul0[1] + tmp.v1[3] + tmp.mul1[3]
+	return h1, h2
+}
+
+func (h *highwayhash) Sum256() (uint64, uint64, uint64, uint64) {
+	tmp := h.finalize(10)
+	h2, h1 := highwayModularReduction(tmp.v1[1]+tmp.mul1[1], tmp.v1[0]+tmp.mul1[0], tmp.v0[1]+tmp.mul0[1], tmp.v0[0]+tmp.mul0[0])
+	h4, h3 := highwayModularReduction(tmp.v1[3]+tmp.mul1[3], tmp.v1[2]+tmp.mul1[2], tmp.v0[3]+tmp.mul0[3], tmp.v0[2]+tmp.mul0[2])
+	return h1, h2, h3, h4
+}
+
+// reduces the 256-bit value a3:a2:a1:a0 modulo x^128 + x^2 + x
+func highwayModularReduction(a3, a2, a1, a0 uint64) (m1, m0 uint64) {
+	a3 &= 0x3fffffffffffffff
+	m1 = a1 ^ (a3<<1 | a2>>63) ^ (a3<<2 | a2>>62)
+	m0 = a0 ^ (a2 << 1) ^ (a2 << 2)
+	return m1, m0
+}
@@ -0,0 +1,172 @@
+// This file is an implementation of invertible integer hash functions by Austin Appleby, Thomas Wang, Bob Jenkins, Guy Steele et al, and Donald Knuth
+// The functions are from MurmurHash3, Wang's "Integer Hash Function" (1997), http://burtleburtle.net/bob/hash/integer.html, SplitMix64 and TAOCP volume 3
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// Each of these functions is a permutation of the integers of its size, and
+// comes with its inverse.  The steps are all multiplications by odd
+// constants, additions of constants, and xorshifts, each of which can be
+// undone; the inverses undo them in reverse order.
+
+package dgohash
+
+// undo x ^= x >> s
+func unxorshiftRight32(x uint32, s uint) uint32 {
+	for i := s; i < 32; i <<= 1 {
+		x ^= x >> i
+	}
+	return x
+}
+
+// undo x ^= x >> s
+func unxorshiftRight64(x uint64, s uint) uint64 {
+	for i := s; i < 64; i <<= 1 {
+		x ^= x >> i
+	}
+	return x
+}
+
+// Fmix32 returns the murmur3 32-bit finalization mix of x
+func Fmix32(x uint32) uint32 {
+	return fmix32(x)
+}
+
+// Fmix32Inverse returns the y for which Fmix32(y) == x
+func Fmix32Inverse(x uint32) uint32 {
+	x = unxorshiftRight32(x, 16)
+	x *= 0x7ed1b41d // 0xc2b2ae35^-1
+	x = unxorshiftRight32(x, 13)
+	x *= 0xa5cb9243 // 0x85ebca6b^-1
+	x = unxorshiftRight32(x, 16)
+	return x
+}
+
+// Fmix64 returns the murmur3 64-bit finalization mix of x
+func Fmix64(x uint64) uint64 {
+	return fmix64(x)
+}
+
+// Fmix64Inverse returns the y for which Fmix64(y) == x
+func Fmix64Inverse(x uint64) uint64 {
+	x = unxorshiftRight64(x, 33)
+	x *= 0x9cb4b2f8129337db // 0xc4ceb9fe1a85ec53^-1
+	x = unxorshiftRight64(x, 33)
+	x *= 0x4f74430c22a54005 // 0xff51afd7ed558ccd^-1
+	x = unxorshiftRight64(x, 33)
+	return x
+}
+
+// Wang32 returns Thomas Wang's hash32shift() of x
+func Wang32(x uint32) uint32 {
+	x = ^x + (x << 15)
+	x ^= x >> 12
+	x += x << 2
+	x ^= x >> 4
+	x *= 2057
+	x ^= x >> 16
+	return x
+}
+
+// Wang32Inverse returns the y for which Wang32(y) == x
+func Wang32Inverse(x uint32) uint32 {
+	x = unxorshiftRight32(x, 16)
+	x *= 0xc8de0639 // 2057^-1
+	x = unxorshiftRight32(x, 4)
+	x *= 0xcccccccd // 5^-1
+	x = unxorshiftRight32(x, 12)
+	// ^x + (x << 15) is x*(2^15-1) - 1
+	x = (x + 1) * 0xbfff7fff // (2^15-1)^-1
+	return x
+}
+
+// Wang64 returns Thomas Wang's hash64shift() of x
+func Wang64(x uint64) uint64 {
+	x = ^x + (x << 21)
+	x ^= x >> 24
+	x = (x + (x << 3)) + (x << 8)
+	x ^= x >> 14
+	x = (x + (x << 2)) + (x << 4)
+	x ^= x >> 28
+	x += x << 31
+	return x
+}
+
+// Wang64Inverse returns the y for which Wang64(y) == x
+func Wang64Inverse(x uint64) uint64 {
+	x *= 0x3fffffff80000001 // (2^31+1)^-1
+	x = unxorshiftRight64(x, 28)
+	x *= 0xcf3cf3cf3cf3cf3d // 21^-1
+	x = unxorshiftRight64(x, 14)
+	x *= 0xd38ff08b1c03dd39 // 265^-1
+	x = unxorshiftRight64(x, 24)
+	// ^x + (x << 21) is x*(2^21-1) - 1
+	x = (x + 1) * 0x7ffffbffffdfffff // (2^21-1)^-1
+	return x
+}
+
+// JenkinsInt32 returns Bob Jenkins' 6-shift 32-bit integer hash of x
+func JenkinsInt32(x uint32) uint32 {
+	x = (x + 0x7ed55d16) + (x << 12)
+	x = (x ^ 0xc761c23c) ^ (x >> 19)
+	x = (x + 0x165667b1) + (x << 5)
+	x = (x + 0xd3a2646c) ^ (x << 9)
+	x = (x + 0xfd7046c5) + (x << 3)
+	x = (x ^ 0xb55a4f09) ^ (x >> 16)
+	return x
+}
+
+// JenkinsInt32Inverse returns the y for which JenkinsInt32(y) == x
+func JenkinsInt32Inverse(x uint32) uint32 {
+	x = unxorshiftRight32(x^0xb55a4f09, 16)
+	x = (x - 0xfd7046c5) * 0x38e38e39 // 9^-1
+
+	// (y + c) ^ (y << 9): each pass recovers another 9 low bits of y
+	y := uint32(0)
+	for i := 0; i < 4; i++ {
+		y = (x ^ (y << 9)) - 0xd3a2646c
+	}
+	x = y
+
+	x = (x - 0x165667b1) * 0x3e0f83e1 // 33^-1
+	x = unxorshiftRight32(x^0xc761c23c, 19)
+	x = (x - 0x7ed55d16) * 0x00fff001 // 4097^-1
+	return x
+}
+
+// Splitmix64 returns the splitmix64 hash of x.  Splitmix64(s) is the first output of the splitmix64 generator with state s.
+func Splitmix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb
+	return x ^ (x >> 31)
+}
+
+// Splitmix64Inverse returns the y for which Splitmix64(y) == x
+func Splitmix64Inverse(x uint64) uint64 {
+	x = unxorshiftRight64(x, 31)
+	x *= 0x319642b2d24d8ec3 // 0x94d049bb133111eb^-1
+	x = unxorshiftRight64(x, 27)
+	x *= 0x96de1b173f119089 // 0xbf58476d1ce4e5b9^-1
+	x = unxorshiftRight64(x, 30)
+	return x - 0x9e3779b97f4a7c15
+}
+
+// Knuth32 returns Knuth's multiplicative hash of x, x*2654435761.  Use the high bits of the result to index a table.
+func Knuth32(x uint32) uint32 {
+	return x * 2654435761
+}
+
+// Knuth32Inverse returns the y for which Knuth32(y) == x
+func Knuth32Inverse(x uint32) uint32 {
+	return x * 0x0e8b2f51 // 2654435761^-1
+}
+
+// Knuth64 returns the 64-bit multiplicative hash of x, x*0x9e3779b97f4a7c15 (2^64 divided by the golden ratio).  Use the high bits of the result to index a table.
+func Knuth64(x uint64) uint64 {
+	return x * 0x9e3779b97f4a7c15
+}
+
+// Knuth64Inverse returns the y for which Knuth64(y) == x
+func Knuth64Inverse(x uint64) uint64 {
+	return x * 0xf1de83e19937733d // 0x9e3779b97f4a7c15^-1
+}
@@ -0,0 +1,152 @@
+// This file is an implementation of the lookup2 hash function by Bob Jenkins
+// The code is translated from the public domain source code at http://burtleburtle.net/bob/c/lookup2.c
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// lookup2 has been superseded by lookup3, but is still found in older code,
+// for example PostgreSQL before 8.4.  Unlike lookup3 the length is only mixed
+// in at the end, so this version doesn't need to buffer its input.
+
+package dgohash
+
+import (
+	"hash"
+)
+
+type jenkinsLookup2 struct {
+	initval uint32
+	a, b, c uint32   // our hash state
+	length  int      // total bytes written so far
+	t       [12]byte // as-yet-unprocessed bytes
+	rem     int      // how many bytes in t[] are valid
+}
+
+// NewJenkinsLookup2 returns a new hash.Hash32 object computing the lookup2 hash() function with the given initval
+func NewJenkinsLookup2(initval uint32) hash.Hash32 {
+	j := new(jenkinsLookup2)
+	j.initval = initval
+	j.Reset()
+	return j
+}
+
+// JenkinsLookup2 returns the lookup2 hash() hash of k with the given initval
+func JenkinsLookup2(k []byte, initval uint32) uint32 {
+	j := jenkinsLookup2{initval: initval}
+	j.Reset()
+	j.Write(k)
+	return j.Sum32()
+}
+
+func (j *jenkinsLookup2) Size() int      { return 4 }
+func (j *jenkinsLookup2) BlockSize() int { return 12 }
+func (j *jenkinsLookup2) Reset() {
+	// the golden ratio; an arbitrary value
+	j.a = 0x9e3779b9
+	j.b = 0x9e3779b9
+	j.c = j.initval
+	j.length = 0
+	j.rem = 0
+}
+
+// mix 3 32-bit values reversibly.
+func lookup2Mix(a, b, c uint32) (uint32, uint32, uint32) {
+	a -= b
+	a -= c
+	a ^= (c >> 13)
+	b -= c
+	b -= a
+	b ^= (a << 8)
+	c -= a
+	c -= b
+	c ^= (b >> 13)
+	a -= b
+	a -= c
+	a ^= (c >> 12)
+	b -= c
+	b -= a
+	b ^= (a << 16)
+	c -= a
+	c -= b
+	c ^= (b >> 5)
+	a -= b
+	a -= c
+	a ^= (c >> 3)
+	b -= c
+	b -= a
+	b ^= (a << 10)
+	c -= a
+	c -= b
+	c ^= (b >> 15)
+	return a, b, c
+}
+
+func (j *jenkinsLookup2) update(k []byte) {
+	j.a += readLE32(k)
+	j.b += readLE32(k[4:])
+	j.c += readLE32(k[8:])
+	j.a, j.b, j.c = lookup2Mix(j.a, j.b, j.c)
+}
+
+func (j *jenkinsLookup2) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+	j.length += datalen
+
+	if j.rem != 0 {
+
+		n := copy(j.t[j.rem:], data)
+		j.rem += n
+
+		if j.rem < 12 {
+			return datalen, nil
+		}
+
+		j.update(j.t[:])
+
+		// nothing is left in the tail
+		j.rem = 0
+		data = data[n:]
+	}
+
+	length := len(data)
+
+	// figure out the length of the tail, and round down b
+	rem := length % 12
+	b := length - rem
+
+	for i := 0; i < b; i += 12 {
+		j.update(data[i:])
+	}
+
+	// copy the tail for later
+	copy(j.t[:rem], data[b:])
+
+	j.rem = rem
+
+	return datalen, nil
+}
+
+func (j *jenkinsLookup2) Sum32() uint32 {
+
+	a, b, c := j.a, j.b, j.c
+
+	// handle the last 11 bytes
+	var tail [12]byte
+	copy(tail[:], j.t[:j.rem])
+
+	// the first byte of c is reserved for the length
+	c += uint32(j.length)
+
+	a += readLE32(tail[:])
+	b += readLE32(tail[4:])
+	c += readLE32(tail[8:]) << 8
+
+	_, _, c = lookup2Mix(a, b, c)
+
+	return c
+}
+
+func (j *jenkinsLookup2) Sum(b []byte) []byte {
+	v := j.Sum32()
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
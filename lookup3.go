@@ -0,0 +1,176 @@
+// This file is an implementation of the lookup3 hash functions by Bob Jenkins
+// The code is translated from the public domain source code at http://burtleburtle.net/bob/c/lookup3.c
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// hashlittle() is the function used by PostgreSQL, Hadoop and (as jhash) the
+// Linux kernel.  hashbig() is the same function reading big-endian words.
+// The initial state depends on the length of the input, so the hash.Hash
+// versions buffer everything written until the sum is requested.
+
+package dgohash
+
+import (
+	"hash"
+)
+
+// NewJenkinsHashLittle returns a new hash.Hash32 object computing the lookup3 hashlittle() function with the given initval.
+// It keeps a copy of everything written until Sum32 is called, so memory use grows with the size of the input.
+func NewJenkinsHashLittle(initval uint32) hash.Hash32 {
+	return &buffered32{f: func(data []byte) uint32 { return JenkinsHashLittle(data, initval) }, blockSize: 12}
+}
+
+// NewJenkinsHashLittle2 returns a new hash.Hash64 object computing the lookup3 hashlittle2() function with the given initvals.
+// The 64-bit hash is c + b<<32, as suggested by the reference code.  Like NewJenkinsHashLittle, it buffers the whole input.
+func NewJenkinsHashLittle2(pc, pb uint32) hash.Hash64 {
+	return &buffered64{f: func(data []byte) uint64 {
+		c, b := JenkinsHashLittle2(data, pc, pb)
+		return uint64(c) | uint64(b)<<32
+	}, blockSize: 12}
+}
+
+// NewJenkinsHashBig returns a new hash.Hash32 object computing the lookup3 hashbig() function with the given initval.
+// Like NewJenkinsHashLittle, it buffers the whole input.
+func NewJenkinsHashBig(initval uint32) hash.Hash32 {
+	return &buffered32{f: func(data []byte) uint32 { return JenkinsHashBig(data, initval) }, blockSize: 12}
+}
+
+// mix 3 32-bit values reversibly.
+func lookup3Mix(a, b, c uint32) (uint32, uint32, uint32) {
+	a -= c
+	a ^= rotl32(c, 4)
+	c += b
+	b -= a
+	b ^= rotl32(a, 6)
+	a += c
+	c -= b
+	c ^= rotl32(b, 8)
+	b += a
+	a -= c
+	a ^= rotl32(c, 16)
+	c += b
+	b -= a
+	b ^= rotl32(a, 19)
+	a += c
+	c -= b
+	c ^= rotl32(b, 4)
+	b += a
+	return a, b, c
+}
+
+// final mixing of 3 32-bit values (a,b,c) into c
+func lookup3Final(a, b, c uint32) (uint32, uint32, uint32) {
+	c ^= b
+	c -= rotl32(b, 14)
+	a ^= c
+	a -= rotl32(c, 11)
+	b ^= a
+	b -= rotl32(a, 25)
+	c ^= b
+	c -= rotl32(b, 16)
+	a ^= c
+	a -= rotl32(c, 4)
+	b ^= a
+	b -= rotl32(a, 14)
+	c ^= b
+	c -= rotl32(b, 24)
+	return a, b, c
+}
+
+// lookup3 is the body shared by hashlittle(), hashlittle2() and hashbig()
+func lookup3(k []byte, a, b, c uint32, read func([]byte) uint32) (uint32, uint32) {
+
+	length := len(k)
+
+	// all but the last block: affect some 32 bits of (a,b,c)
+	for ; length > 12; length -= 12 {
+		a += read(k)
+		b += read(k[4:])
+		c += read(k[8:])
+		a, b, c = lookup3Mix(a, b, c)
+		k = k[12:]
+	}
+
+	// zero length strings require no mixing
+	if length == 0 {
+		return c, b
+	}
+
+	// last block: affect all 32 bits of (c)
+	var tail [12]byte
+	copy(tail[:], k)
+
+	a += read(tail[:])
+	b += read(tail[4:])
+	c += read(tail[8:])
+
+	_, b, c = lookup3Final(a, b, c)
+
+	return c, b
+}
+
+// JenkinsHashLittle returns the lookup3 hashlittle() hash of k with the given initval
+func JenkinsHashLittle(k []byte, initval uint32) uint32 {
+	a := 0xdeadbeef + uint32(len(k)) + initval
+	c, _ := lookup3(k, a, a, a, readLE32)
+	return c
+}
+
+// JenkinsHashLittle2 returns the two 32-bit values computed by the lookup3 hashlittle2() function.
+// pc and pb are the two initvals; pc is the better mixed of the two results and is the same as JenkinsHashLittle(k, pc) when pb is 0.
+func JenkinsHashLittle2(k []byte, pc, pb uint32) (uint32, uint32) {
+	a := 0xdeadbeef + uint32(len(k)) + pc
+	return lookup3(k, a, a, a+pb, readLE32)
+}
+
+// JenkinsHashBig returns the lookup3 hashbig() hash of k with the given initval
+func JenkinsHashBig(k []byte, initval uint32) uint32 {
+	a := 0xdeadbeef + uint32(len(k)) + initval
+	c, _ := lookup3(k, a, a, a, readBE32)
+	return c
+}
+
+// JenkinsHashWord returns the lookup3 hashword() hash of the array of uint32s k with the given initval.
+// On little-endian machines this is the same as JenkinsHashLittle of the bytes of k.
+func JenkinsHashWord(k []uint32, initval uint32) uint32 {
+	c, _ := JenkinsHashWord2(k, initval, 0)
+	return c
+}
+
+// JenkinsHashWord2 returns the two 32-bit values computed by the lookup3 hashword2() function
+func JenkinsHashWord2(k []uint32, pc, pb uint32) (uint32, uint32) {
+
+	a := 0xdeadbeef + uint32(len(k))<<2 + pc
+	b := a
+	c := a + pb
+
+	// handle most of the key
+	for len(k) > 3 {
+		a += k[0]
+		b += k[1]
+		c += k[2]
+		a, b, c = lookup3Mix(a, b, c)
+		k = k[3:]
+	}
+
+	// handle the last 3 uint32s
+	switch len(k) {
+	case 3:
+		c += k[2]
+		fallthrough
+	case 2:
+		b += k[1]
+		fallthrough
+	case 1:
+		a += k[0]
+		_, b, c = lookup3Final(a, b, c)
+	case 0:
+		// nothing left to add
+	}
+
+	return c, b
+}
+
+func readBE32(b []byte) uint32 {
+	return uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+}
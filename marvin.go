@@ -8,10 +8,12 @@ package dgohash
 
 import (
 	"hash"
+	"unicode/utf16"
 )
 
 type marvin struct {
 	seed   uint64
+	size   int // output size in bytes, 4 or 8
 	lo, hi uint32
 	t      [4]byte // as-yet-unprocessed bytes
 	rem    int     // how many bytes in t[] are valid
@@ -30,11 +32,37 @@ func (m *marvin) update(v uint32) {
 func NewMarvin32(seed uint64) hash.Hash32 {
 	m := new(marvin)
 	m.seed = seed
+	m.size = 4
 	m.Reset()
 	return m
 }
 
-func (m *marvin) Size() int      { return 4 }
+// NewMarvin64 returns a new hash.Hash64 object computing the full 64-bit Marvin state, as .NET's Marvin.ComputeHash does.
+func NewMarvin64(seed uint64) hash.Hash64 {
+	m := new(marvin)
+	m.seed = seed
+	m.size = 8
+	m.Reset()
+	return m
+}
+
+// Marvin32String returns the Marvin32 hash of the UTF-16LE encoding of s, which is how the CLR hashes strings.
+// With the same seed, int32(Marvin32String(seed, s)) is the value of .NET's string.GetHashCode().
+// Invalid UTF-8 in s is hashed as U+FFFD.
+func Marvin32String(seed uint64, s string) uint32 {
+	u := utf16.Encode([]rune(s))
+	b := make([]byte, 2*len(u))
+	for i, v := range u {
+		b[2*i] = byte(v)
+		b[2*i+1] = byte(v >> 8)
+	}
+	m := marvin{seed: seed}
+	m.Reset()
+	m.Write(b)
+	return m.Sum32()
+}
+
+func (m *marvin) Size() int      { return m.size }
 func (m *marvin) BlockSize() int { return 4 }
 func (m *marvin) Reset()         { m.lo = uint32(m.seed); m.hi = uint32(m.seed >> 32); m.rem = 0 }
 
@@ -98,6 +126,10 @@ func (m *marvin) Write(data []byte) (int, error) {
 }
 
 func (m *marvin) Sum(b []byte) []byte {
+	if m.size == 8 {
+		v := m.Sum64()
+		return append(b, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+	}
 	h1 := m.Sum32()
 	p := make([]byte, 4)
 	p[0] = byte(h1 >> 24)
@@ -111,7 +143,7 @@ func (m *marvin) Sum(b []byte) []byte {
 }
 
 // marvin finalize step
-func (m *marvin) Sum32() uint32 {
+func (m *marvin) finalize() (lo, hi uint32) {
 
 	/* pad the final 0-3 bytes with 0x80 */
 	final := uint32(0x80)
@@ -134,5 +166,16 @@ func (m *marvin) Sum32() uint32 {
 	mTmp.update(final)
 	mTmp.update(0)
 
-	return mTmp.lo ^ mTmp.hi
+	return mTmp.lo, mTmp.hi
+}
+
+func (m *marvin) Sum32() uint32 {
+	lo, hi := m.finalize()
+	return lo ^ hi
+}
+
+// Sum64 returns the full 64-bit state, with the high word in the top 32 bits.  Sum32 is the two words xored together.
+func (m *marvin) Sum64() uint64 {
+	lo, hi := m.finalize()
+	return uint64(hi)<<32 | uint64(lo)
 }
@@ -0,0 +1,204 @@
+// This file is an implementation of the 128-bit MetroHash functions by J. Andrew Rogers
+// The code is translated from the Apache-licensed source code at https://github.com/jandrewrogers/MetroHash
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// As with the 64-bit versions, metrohash128_1() and metrohash128_2() mix the
+// length into their initial state, so it must be given to the constructors.
+// The two halves of the hash are returned in the order the reference code
+// writes them out.
+
+package dgohash
+
+import (
+	"encoding/binary"
+)
+
+type metro128 struct {
+	metroBlocks
+	seed   uint32
+	length uint64 // the expected length of the input
+	final  func(v0, v1, v2, v3 uint64, bulk bool, p []byte) (uint64, uint64)
+}
+
+// NewMetroHash128_1 returns a new Hash128 object computing the metrohash128_1() function with the given seed.
+// The reference code mixes the length of the input into the initial state, so the total number of bytes that
+// will be written must be known in advance.  If a different number of bytes is written the result is meaningless.
+func NewMetroHash128_1(length int, seed uint32) Hash128 {
+	m := &metro128{seed: seed, length: uint64(length), final: metro128_1Final}
+	m.k = metro64_1k
+	m.Reset()
+	return m
+}
+
+// NewMetroHash128_2 returns a new Hash128 object computing the metrohash128_2() function with the given seed.
+// As with NewMetroHash128_1, the total number of bytes that will be written must be known in advance.
+func NewMetroHash128_2(length int, seed uint32) Hash128 {
+	m := &metro128{seed: seed, length: uint64(length), final: metro128_2Final}
+	m.k = metro64_2k
+	m.Reset()
+	return m
+}
+
+func (m *metro128) Size() int { return 16 }
+func (m *metro128) Reset() {
+	s, k := uint64(m.seed), m.k
+	m.v0 = (s-k[0])*k[3] + m.length
+	m.v1 = (s+k[1])*k[2] + m.length
+	m.v2 = (s+k[0])*k[2] + m.length
+	m.v3 = (s-k[1])*k[3] + m.length
+	m.n = 0
+	m.rem = 0
+}
+
+func (m *metro128) Sum(b []byte) []byte {
+	h1, h2 := m.Sum128()
+	return appendSum128(b, h1, h2)
+}
+
+func (m *metro128) Sum64() uint64 {
+	h1, _ := m.Sum128()
+	return h1
+}
+
+func (m *metro128) Sum128() (uint64, uint64) {
+	return m.final(m.v0, m.v1, m.v2, m.v3, m.n >= 32, m.t[:m.rem])
+}
+
+// MetroHash128_1 returns the metrohash128_1() hash of data with the given seed
+func MetroHash128_1(data []byte, seed uint32) (uint64, uint64) {
+	m := NewMetroHash128_1(len(data), seed)
+	m.Write(data)
+	return m.Sum128()
+}
+
+// finish metrohash128_1(), given the state of the bulk loop, whether it was used, and the tail p
+func metro128_1Final(v0, v1, v2, v3 uint64, bulk bool, p []byte) (uint64, uint64) {
+
+	const (
+		k0 = 0xC83A91E1
+		k1 = 0x8648DBDB
+		k2 = 0x7BDEC03B
+		k3 = 0x2F5870A5
+	)
+
+	if bulk {
+		v2 ^= rotr64(((v0+v3)*k0)+v1, 26) * k1
+		v3 ^= rotr64(((v1+v2)*k1)+v0, 26) * k0
+		v0 ^= rotr64(((v0+v2)*k0)+v3, 26) * k1
+		v1 ^= rotr64(((v1+v3)*k1)+v2, 30) * k0
+	}
+
+	if len(p) >= 16 {
+		v0 += readLE64(p) * k2
+		v0 = rotr64(v0, 33) * k3
+		v1 += readLE64(p[8:]) * k2
+		v1 = rotr64(v1, 33) * k3
+		v0 ^= rotr64((v0*k2)+v1, 17) * k1
+		v1 ^= rotr64((v1*k3)+v0, 17) * k0
+		p = p[16:]
+	}
+
+	if len(p) >= 8 {
+		v0 += readLE64(p) * k2
+		v0 = rotr64(v0, 33) * k3
+		v0 ^= rotr64((v0*k2)+v1, 20) * k1
+		p = p[8:]
+	}
+
+	if len(p) >= 4 {
+		v1 += uint64(readLE32(p)) * k2
+		v1 = rotr64(v1, 33) * k3
+		v1 ^= rotr64((v1*k3)+v0, 18) * k0
+		p = p[4:]
+	}
+
+	if len(p) >= 2 {
+		v0 += uint64(binary.LittleEndian.Uint16(p)) * k2
+		v0 = rotr64(v0, 33) * k3
+		v0 ^= rotr64((v0*k2)+v1, 24) * k1
+		p = p[2:]
+	}
+
+	if len(p) >= 1 {
+		v1 += uint64(p[0]) * k2
+		v1 = rotr64(v1, 33) * k3
+		v1 ^= rotr64((v1*k3)+v0, 24) * k0
+	}
+
+	v0 += rotr64((v0*k0)+v1, 13)
+	v1 += rotr64((v1*k1)+v0, 37)
+	v0 += rotr64((v0*k2)+v1, 13)
+	v1 += rotr64((v1*k3)+v0, 37)
+
+	return v0, v1
+}
+
+// MetroHash128_2 returns the metrohash128_2() hash of data with the given seed
+func MetroHash128_2(data []byte, seed uint32) (uint64, uint64) {
+	m := NewMetroHash128_2(len(data), seed)
+	m.Write(data)
+	return m.Sum128()
+}
+
+// finish metrohash128_2(), given the state of the bulk loop, whether it was used, and the tail p
+func metro128_2Final(v0, v1, v2, v3 uint64, bulk bool, p []byte) (uint64, uint64) {
+
+	const (
+		k0 = 0xD6D018F5
+		k1 = 0xA2AA033B
+		k2 = 0x62992FC1
+		k3 = 0x30BC5B29
+	)
+
+	if bulk {
+		v2 ^= rotr64(((v0+v3)*k0)+v1, 33) * k1
+		v3 ^= rotr64(((v1+v2)*k1)+v0, 33) * k0
+		v0 ^= rotr64(((v0+v2)*k0)+v3, 33) * k1
+		v1 ^= rotr64(((v1+v3)*k1)+v2, 33) * k0
+	}
+
+	if len(p) >= 16 {
+		v0 += readLE64(p) * k2
+		v0 = rotr64(v0, 29) * k3
+		v1 += readLE64(p[8:]) * k2
+		v1 = rotr64(v1, 29) * k3
+		v0 ^= rotr64((v0*k2)+v1, 29) * k1
+		v1 ^= rotr64((v1*k3)+v0, 29) * k0
+		p = p[16:]
+	}
+
+	if len(p) >= 8 {
+		v0 += readLE64(p) * k2
+		v0 = rotr64(v0, 29) * k3
+		v0 ^= rotr64((v0*k2)+v1, 29) * k1
+		p = p[8:]
+	}
+
+	if len(p) >= 4 {
+		v1 += uint64(readLE32(p)) * k2
+		v1 = rotr64(v1, 29) * k3
+		v1 ^= rotr64((v1*k3)+v0, 25) * k0
+		p = p[4:]
+	}
+
+	if len(p) >= 2 {
+		v0 += uint64(binary.LittleEndian.Uint16(p)) * k2
+		v0 = rotr64(v0, 29) * k3
+		v0 ^= rotr64((v0*k2)+v1, 30) * k1
+		p = p[2:]
+	}
+
+	if len(p) >= 1 {
+		v1 += uint64(p[0]) * k2
+		v1 = rotr64(v1, 29) * k3
+		v1 ^= rotr64((v1*k3)+v0, 18) * k0
+	}
+
+	v0 += rotr64((v0*k0)+v1, 33)
+	v1 += rotr64((v1*k1)+v0, 33)
+	v0 += rotr64((v0*k2)+v1, 33)
+	v1 += rotr64((v1*k3)+v0, 33)
+
+	return v0, v1
+}
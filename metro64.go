@@ -0,0 +1,263 @@
+// This file is an implementation of the 64-bit MetroHash functions by J. Andrew Rogers
+// The code is translated from the Apache-licensed source code at https://github.com/jandrewrogers/MetroHash
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// The metrohash64_1() and metrohash64_2() functions add the length of the
+// input to their initial state.  As with NewSuperFastHashLength, the hash.Hash
+// versions are told the total length up front, and then process the input 32
+// bytes at a time, keeping only the partial block between calls to Write.
+
+package dgohash
+
+import (
+	"encoding/binary"
+	"hash"
+)
+
+// metroBlocks holds the state of the bulk loop shared by the MetroHash functions
+type metroBlocks struct {
+	k              [4]uint64 // the multipliers for this variant
+	v0, v1, v2, v3 uint64    // our hash state
+	n              uint64    // total bytes written so far
+	t              [32]byte  // as-yet-unprocessed bytes
+	rem            int       // how many bytes in t[] are valid
+}
+
+func (m *metroBlocks) BlockSize() int { return 32 }
+
+// computes new hash state merged with the 32 bytes in p
+func (m *metroBlocks) update(p []byte) {
+	m.v0 += readLE64(p) * m.k[0]
+	m.v0 = rotr64(m.v0, 29) + m.v2
+	m.v1 += readLE64(p[8:]) * m.k[1]
+	m.v1 = rotr64(m.v1, 29) + m.v3
+	m.v2 += readLE64(p[16:]) * m.k[2]
+	m.v2 = rotr64(m.v2, 29) + m.v0
+	m.v3 += readLE64(p[24:]) * m.k[3]
+	m.v3 = rotr64(m.v3, 29) + m.v1
+}
+
+func (m *metroBlocks) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+	m.n += uint64(datalen)
+
+	if m.rem != 0 {
+
+		n := copy(m.t[m.rem:], data)
+		m.rem += n
+
+		if m.rem < 32 {
+			return datalen, nil
+		}
+
+		m.update(m.t[:])
+
+		// nothing is left in the tail
+		m.rem = 0
+		data = data[n:]
+	}
+
+	length := len(data)
+
+	// figure out the length of the tail, and round down b
+	rem := length & 31
+	b := length - rem
+
+	for i := 0; i < b; i += 32 {
+		m.update(data[i:])
+	}
+
+	// copy the tail for later
+	copy(m.t[:rem], data[b:])
+
+	m.rem = rem
+
+	return datalen, nil
+}
+
+type metro64 struct {
+	metroBlocks
+	seed   uint32
+	length uint64 // the expected length of the input
+	final  func(h, v0, v1, v2, v3 uint64, bulk bool, p []byte) uint64
+}
+
+var (
+	metro64_1k = [4]uint64{0xC83A91E1, 0x8648DBDB, 0x7BDEC03B, 0x2F5870A5}
+	metro64_2k = [4]uint64{0xD6D018F5, 0xA2AA033B, 0x62992FC1, 0x30BC5B29}
+)
+
+// NewMetroHash64_1 returns a new hash.Hash64 object computing the metrohash64_1() function with the given seed.
+// The reference code mixes the length of the input into the initial state, so the total number of bytes that
+// will be written must be known in advance.  If a different number of bytes is written the result is meaningless.
+func NewMetroHash64_1(length int, seed uint32) hash.Hash64 {
+	m := &metro64{seed: seed, length: uint64(length), final: metro64_1Final}
+	m.k = metro64_1k
+	m.Reset()
+	return m
+}
+
+// NewMetroHash64_2 returns a new hash.Hash64 object computing the metrohash64_2() function with the given seed.
+// As with NewMetroHash64_1, the total number of bytes that will be written must be known in advance.
+func NewMetroHash64_2(length int, seed uint32) hash.Hash64 {
+	m := &metro64{seed: seed, length: uint64(length), final: metro64_2Final}
+	m.k = metro64_2k
+	m.Reset()
+	return m
+}
+
+func (m *metro64) Size() int { return 8 }
+func (m *metro64) Reset() {
+	h := m.init()
+	m.v0, m.v1, m.v2, m.v3 = h, h, h, h
+	m.n = 0
+	m.rem = 0
+}
+
+// the initial state, which is also added to the result of the bulk loop
+func (m *metro64) init() uint64 {
+	return (uint64(m.seed)+m.k[2])*m.k[0] + m.length
+}
+
+func (m *metro64) Sum(b []byte) []byte {
+	v := m.Sum64()
+	return append(b, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (m *metro64) Sum64() uint64 {
+	return m.final(m.init(), m.v0, m.v1, m.v2, m.v3, m.n >= 32, m.t[:m.rem])
+}
+
+// MetroHash64_1 returns the metrohash64_1() hash of data with the given seed
+func MetroHash64_1(data []byte, seed uint32) uint64 {
+	m := NewMetroHash64_1(len(data), seed)
+	m.Write(data)
+	return m.Sum64()
+}
+
+// finish metrohash64_1(), given the initial state h, the state of the bulk loop if it was used, and the tail p
+func metro64_1Final(h, v0, v1, v2, v3 uint64, bulk bool, p []byte) uint64 {
+
+	const (
+		k0 = 0xC83A91E1
+		k1 = 0x8648DBDB
+		k2 = 0x7BDEC03B
+		k3 = 0x2F5870A5
+	)
+
+	if bulk {
+		v2 ^= rotr64(((v0+v3)*k0)+v1, 33) * k1
+		v3 ^= rotr64(((v1+v2)*k1)+v0, 33) * k0
+		v0 ^= rotr64(((v0+v2)*k0)+v3, 33) * k1
+		v1 ^= rotr64(((v1+v3)*k1)+v2, 33) * k0
+		h += v0 ^ v1
+	}
+
+	if len(p) >= 16 {
+		v0 = h + readLE64(p)*k0
+		v0 = rotr64(v0, 33) * k1
+		v1 = h + readLE64(p[8:])*k1
+		v1 = rotr64(v1, 33) * k2
+		v0 ^= rotr64(v0*k0, 35) + v1
+		v1 ^= rotr64(v1*k3, 35) + v0
+		h += v1
+		p = p[16:]
+	}
+
+	if len(p) >= 8 {
+		h += readLE64(p) * k3
+		h ^= rotr64(h, 33) * k1
+		p = p[8:]
+	}
+
+	if len(p) >= 4 {
+		h += uint64(readLE32(p)) * k3
+		h ^= rotr64(h, 15) * k1
+		p = p[4:]
+	}
+
+	if len(p) >= 2 {
+		h += uint64(binary.LittleEndian.Uint16(p)) * k3
+		h ^= rotr64(h, 13) * k1
+		p = p[2:]
+	}
+
+	if len(p) >= 1 {
+		h += uint64(p[0]) * k3
+		h ^= rotr64(h, 25) * k1
+	}
+
+	h ^= rotr64(h, 33)
+	h *= k0
+	h ^= rotr64(h, 33)
+
+	return h
+}
+
+// MetroHash64_2 returns the metrohash64_2() hash of data with the given seed
+func MetroHash64_2(data []byte, seed uint32) uint64 {
+	m := NewMetroHash64_2(len(data), seed)
+	m.Write(data)
+	return m.Sum64()
+}
+
+// finish metrohash64_2(), given the initial state h, the state of the bulk loop if it was used, and the tail p
+func metro64_2Final(h, v0, v1, v2, v3 uint64, bulk bool, p []byte) uint64 {
+
+	const (
+		k0 = 0xD6D018F5
+		k1 = 0xA2AA033B
+		k2 = 0x62992FC1
+		k3 = 0x30BC5B29
+	)
+
+	if bulk {
+		v2 ^= rotr64(((v0+v3)*k0)+v1, 30) * k1
+		v3 ^= rotr64(((v1+v2)*k1)+v0, 30) * k0
+		v0 ^= rotr64(((v0+v2)*k0)+v3, 30) * k1
+		v1 ^= rotr64(((v1+v3)*k1)+v2, 30) * k0
+		h += v0 ^ v1
+	}
+
+	if len(p) >= 16 {
+		v0 = h + readLE64(p)*k2
+		v0 = rotr64(v0, 29) * k3
+		v1 = h + readLE64(p[8:])*k2
+		v1 = rotr64(v1, 29) * k3
+		v0 ^= rotr64(v0*k0, 34) + v1
+		v1 ^= rotr64(v1*k3, 34) + v0
+		h += v1
+		p = p[16:]
+	}
+
+	if len(p) >= 8 {
+		h += readLE64(p) * k3
+		h ^= rotr64(h, 36) * k1
+		p = p[8:]
+	}
+
+	if len(p) >= 4 {
+		h += uint64(readLE32(p)) * k3
+		h ^= rotr64(h, 15) * k1
+		p = p[4:]
+	}
+
+	if len(p) >= 2 {
+		h += uint64(binary.LittleEndian.Uint16(p)) * k3
+		h ^= rotr64(h, 15) * k1
+		p = p[2:]
+	}
+
+	if len(p) >= 1 {
+		h += uint64(p[0]) * k3
+		h ^= rotr64(h, 23) * k1
+	}
+
+	h ^= rotr64(h, 28)
+	h *= k0
+	h ^= rotr64(h, 29)
+
+	return h
+}
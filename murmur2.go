@@ -0,0 +1,324 @@
+// This file is an implementation of the MurmurHash2 family of hash functions by Austin Appleby
+// The code is translated from the public domain source code at http://code.google.com/p/smhasher/source/browse/trunk/MurmurHash2.cpp
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// MurmurHash2, MurmurHash64A and MurmurHash64B mix the length of the input
+// into their initial state, so the hashes returned for them here have to
+// buffer everything written until the sum is requested.  MurmurHash2A was
+// designed to be computed incrementally and has no such restriction.
+
+package dgohash
+
+import (
+	"hash"
+)
+
+const m2 = uint32(0x5bd1e995)
+const m64 = uint64(0xc6a4a7935bd1e995)
+
+// NewMurmur2 returns a new hash.Hash32 object computing MurmurHash2 with the given seed.
+// It keeps a copy of everything written until Sum32 is called, so memory use grows with the size of the input.
+func NewMurmur2(seed uint32) hash.Hash32 {
+	return &buffered32{f: func(data []byte) uint32 { return murmur2(data, seed) }, blockSize: 4}
+}
+
+// NewMurmurNeutral2 returns a new hash.Hash32 object computing MurmurHashNeutral2 with the given seed.
+// Since all the hashes here read their input as little-endian, this is the same function as NewMurmur2,
+// and like it buffers the whole input.
+func NewMurmurNeutral2(seed uint32) hash.Hash32 {
+	return NewMurmur2(seed)
+}
+
+// NewMurmurAligned2 returns a new hash.Hash32 object computing MurmurHashAligned2 with the given seed.
+// MurmurHashAligned2 only differs from MurmurHash2 in how it reads memory, so this is the same function as NewMurmur2,
+// and like it buffers the whole input.
+func NewMurmurAligned2(seed uint32) hash.Hash32 {
+	return NewMurmur2(seed)
+}
+
+func murmur2(data []byte, seed uint32) uint32 {
+
+	length := len(data)
+
+	h := seed ^ uint32(length)
+
+	for length >= 4 {
+		k := uint32(data[0]) | uint32(data[1])<<8 | uint32(data[2])<<16 | uint32(data[3])<<24
+
+		k *= m2
+		k ^= k >> 24
+		k *= m2
+
+		h *= m2
+		h ^= k
+
+		data = data[4:]
+		length -= 4
+	}
+
+	switch length {
+	case 3:
+		h ^= uint32(data[2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint32(data[1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint32(data[0])
+		h *= m2
+	}
+
+	h ^= h >> 13
+	h *= m2
+	h ^= h >> 15
+
+	return h
+}
+
+// NewMurmur64A returns a new hash.Hash64 object computing MurmurHash64A with the given seed.
+// It keeps a copy of everything written until Sum64 is called, so memory use grows with the size of the input.
+func NewMurmur64A(seed uint64) hash.Hash64 {
+	return &buffered64{f: func(data []byte) uint64 { return murmur64A(data, seed) }, blockSize: 8}
+}
+
+func murmur64A(data []byte, seed uint64) uint64 {
+
+	length := len(data)
+
+	h := seed ^ (uint64(length) * m64)
+
+	for length >= 8 {
+		k := uint64(data[0]) | uint64(data[1])<<8 | uint64(data[2])<<16 | uint64(data[3])<<24 |
+			uint64(data[4])<<32 | uint64(data[5])<<40 | uint64(data[6])<<48 | uint64(data[7])<<56
+
+		k *= m64
+		k ^= k >> 47
+		k *= m64
+
+		h ^= k
+		h *= m64
+
+		data = data[8:]
+		length -= 8
+	}
+
+	switch length {
+	case 7:
+		h ^= uint64(data[6]) << 48
+		fallthrough
+	case 6:
+		h ^= uint64(data[5]) << 40
+		fallthrough
+	case 5:
+		h ^= uint64(data[4]) << 32
+		fallthrough
+	case 4:
+		h ^= uint64(data[3]) << 24
+		fallthrough
+	case 3:
+		h ^= uint64(data[2]) << 16
+		fallthrough
+	case 2:
+		h ^= uint64(data[1]) << 8
+		fallthrough
+	case 1:
+		h ^= uint64(data[0])
+		h *= m64
+	}
+
+	h ^= h >> 47
+	h *= m64
+	h ^= h >> 47
+
+	return h
+}
+
+// NewMurmur64B returns a new hash.Hash64 object computing MurmurHash64B, the 64-bit hash for 32-bit platforms, with the given seed.
+// It keeps a copy of everything written until Sum64 is called, so memory use grows with the size of the input.
+func NewMurmur64B(seed uint64) hash.Hash64 {
+	return &buffered64{f: func(data []byte) uint64 { return murmur64B(data, seed) }, blockSize: 8}
+}
+
+func murmur64B(data []byte, seed uint64) uint64 {
+
+	length := len(data)
+
+	h1 := uint32(seed) ^ uint32(length)
+	h2 := uint32(seed >> 32)
+
+	for length >= 8 {
+		k1 := uint32(data[0]) | uint32(data[1])<<8 | uint32(data[2])<<16 | uint32(data[3])<<24
+		k1 *= m2
+		k1 ^= k1 >> 24
+		k1 *= m2
+		h1 *= m2
+		h1 ^= k1
+
+		k2 := uint32(data[4]) | uint32(data[5])<<8 | uint32(data[6])<<16 | uint32(data[7])<<24
+		k2 *= m2
+		k2 ^= k2 >> 24
+		k2 *= m2
+		h2 *= m2
+		h2 ^= k2
+
+		data = data[8:]
+		length -= 8
+	}
+
+	if length >= 4 {
+		k1 := uint32(data[0]) | uint32(data[1])<<8 | uint32(data[2])<<16 | uint32(data[3])<<24
+		k1 *= m2
+		k1 ^= k1 >> 24
+		k1 *= m2
+		h1 *= m2
+		h1 ^= k1
+
+		data = data[4:]
+		length -= 4
+	}
+
+	switch length {
+	case 3:
+		h2 ^= uint32(data[2]) << 16
+		fallthrough
+	case 2:
+		h2 ^= uint32(data[1]) << 8
+		fallthrough
+	case 1:
+		h2 ^= uint32(data[0])
+		h2 *= m2
+	}
+
+	h1 ^= h2 >> 18
+	h1 *= m2
+	h2 ^= h1 >> 22
+	h2 *= m2
+	h1 ^= h2 >> 17
+	h1 *= m2
+	h2 ^= h1 >> 19
+	h2 *= m2
+
+	return uint64(h1)<<32 | uint64(h2)
+}
+
+type murmur2a struct {
+	seed   uint32
+	h1     uint32  // our hash state
+	length uint32  // current bytes written so far (needed for finalize)
+	t      [4]byte // as-yet-unprocessed bytes
+	rem    int     // how many bytes in t[] are valid
+}
+
+// NewMurmur2A returns a new hash.Hash32 object computing the incremental MurmurHash2A with the given seed
+func NewMurmur2A(seed uint32) hash.Hash32 {
+	m := new(murmur2a)
+	m.seed = seed
+	m.Reset()
+	return m
+}
+
+func (m *murmur2a) Size() int      { return 4 }
+func (m *murmur2a) BlockSize() int { return 4 }
+func (m *murmur2a) Reset()         { m.h1 = m.seed; m.length = 0; m.rem = 0 }
+
+// the mmix() macro from the reference code
+func mmix(h, k uint32) uint32 {
+	k *= m2
+	k ^= k >> 24
+	k *= m2
+	h *= m2
+	h ^= k
+	return h
+}
+
+// virtually identical to murmur3:Write()
+func (m *murmur2a) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+
+	length := datalen
+
+	m.length += uint32(length)
+
+	if m.rem != 0 {
+
+		need := 4 - m.rem
+
+		if length < need {
+			copy(m.t[m.rem:], data[:length])
+			m.rem += length
+
+			return length, nil
+		}
+
+		var k1 uint32
+
+		switch need {
+		case 1:
+			k1 = uint32(m.t[0]) | uint32(m.t[1])<<8 | uint32(m.t[2])<<16 | uint32(data[0])<<24
+		case 2:
+			k1 = uint32(m.t[0]) | uint32(m.t[1])<<8 | uint32(data[0])<<16 | uint32(data[1])<<24
+		case 3:
+			k1 = uint32(m.t[0]) | uint32(data[0])<<8 | uint32(data[1])<<16 | uint32(data[2])<<24
+		}
+
+		m.h1 = mmix(m.h1, k1)
+
+		// we've used up some bytes
+		length -= need
+		// nothing is left in the tail
+		m.rem = 0
+		data = data[need:]
+	}
+
+	// figure out the length of the tail, and round down b
+	rem := length & 3
+	b := length - rem
+
+	for i := 0; i < b; i += 4 {
+		k1 := uint32(data[i]) | uint32(data[i+1])<<8 | uint32(data[i+2])<<16 | uint32(data[i+3])<<24
+		m.h1 = mmix(m.h1, k1)
+	}
+
+	// copy the tail for later
+	copy(m.t[:rem], data[b:])
+
+	m.rem = rem
+
+	return datalen, nil
+}
+
+func (m *murmur2a) Sum(b []byte) []byte {
+	h1 := m.Sum32()
+	return append(b, byte(h1>>24), byte(h1>>16), byte(h1>>8), byte(h1))
+}
+
+// murmur2a finalize step
+func (m *murmur2a) Sum32() uint32 {
+
+	var t uint32
+
+	// copy so as not to change the internal state
+	h1 := m.h1
+
+	switch m.rem {
+	case 3:
+		t ^= uint32(m.t[2]) << 16
+		fallthrough
+	case 2:
+		t ^= uint32(m.t[1]) << 8
+		fallthrough
+	case 1:
+		t ^= uint32(m.t[0])
+	}
+
+	h1 = mmix(h1, t)
+	h1 = mmix(h1, m.length)
+
+	h1 ^= h1 >> 13
+	h1 *= m2
+	h1 ^= h1 >> 15
+
+	return h1
+}
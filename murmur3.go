@@ -3,7 +3,7 @@
 // This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
 // Licensed under the GPLv3, or at your option any later version
 
-// Murmur3 also has 128-bit hashes, which are not (yet) included here.
+// The 128-bit variants are in murmur3_128.go
 
 package dgohash
 
@@ -17,6 +17,7 @@ func rotl32(x uint32, r uint8) uint32 {
 }
 
 type murmur3 struct {
+	seed   uint32
 	h1     uint32  // our hash state
 	length uint32  // current bytes written so far (needed for finalize)
 	t      [4]byte // as-yet-unprocessed bytes
@@ -25,13 +26,21 @@ type murmur3 struct {
 
 func (m *murmur3) Size() int      { return 4 }
 func (m *murmur3) BlockSize() int { return 4 }
-func (m *murmur3) Reset()         { m.h1 = uint32(0); m.length = 0; m.rem = 0 }
+func (m *murmur3) Reset()         { m.h1 = m.seed; m.length = 0; m.rem = 0 }
 
 // NewMurmur3_x86_32 returns a new hash.Hash32 object computing the Murmur3 x86 32-bit hash
 func NewMurmur3_x86_32() hash.Hash32 {
 	return new(murmur3)
 }
 
+// NewMurmur3_x86_32Seed returns a new hash.Hash32 object computing the Murmur3 x86 32-bit hash with the given seed
+func NewMurmur3_x86_32Seed(seed uint32) hash.Hash32 {
+	m := new(murmur3)
+	m.seed = seed
+	m.Reset()
+	return m
+}
+
 const c1 = uint32(0xcc9e2d51)
 const c2 = uint32(0x1b873593)
 
@@ -145,11 +154,15 @@ func (m *murmur3) Sum32() uint32 {
 
 	h1 ^= m.length
 
+	return fmix32(h1)
+}
+
+// murmur3 32-bit finalization mix
+func fmix32(h1 uint32) uint32 {
 	h1 ^= h1 >> 16
 	h1 *= uint32(0x85ebca6b)
 	h1 ^= h1 >> 13
 	h1 *= uint32(0xc2b2ae35)
 	h1 ^= h1 >> 16
-
 	return h1
 }
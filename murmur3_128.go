@@ -0,0 +1,462 @@
+// This file is an implementation of the murmur3 x86_128 and x64_128 hash functions by Austin Appleby
+// The code is translated from the public domain source code at http://code.google.com/p/smhasher/source/browse/trunk/MurmurHash3.cpp
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+package dgohash
+
+import (
+	"encoding/binary"
+	"hash"
+)
+
+// Hash128 is the common interface implemented by all 128-bit hash functions.
+// Sum64 returns the first 64 bits of the value returned by Sum128.
+type Hash128 interface {
+	hash.Hash64
+	Sum128() (uint64, uint64)
+}
+
+// rotate x left by r bits
+func rotl64(x uint64, r uint8) uint64 {
+	return (x << r) | (x >> (64 - r))
+}
+
+// murmur3 64-bit finalization mix
+func fmix64(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+	return k
+}
+
+// append the big-endian bytes of h1 and h2 to b
+func appendSum128(b []byte, h1, h2 uint64) []byte {
+	return append(b,
+		byte(h1>>56), byte(h1>>48), byte(h1>>40), byte(h1>>32), byte(h1>>24), byte(h1>>16), byte(h1>>8), byte(h1),
+		byte(h2>>56), byte(h2>>48), byte(h2>>40), byte(h2>>32), byte(h2>>24), byte(h2>>16), byte(h2>>8), byte(h2),
+	)
+}
+
+type murmur3x86_128 struct {
+	seed           uint32
+	h1, h2, h3, h4 uint32   // our hash state
+	length         uint32   // current bytes written so far (needed for finalize)
+	t              [16]byte // as-yet-unprocessed bytes
+	rem            int      // how many bytes in t[] are valid
+}
+
+// NewMurmur3_x86_128 returns a new Hash128 object computing the Murmur3 x86 128-bit hash
+func NewMurmur3_x86_128() Hash128 {
+	return NewMurmur3_x86_128Seed(0)
+}
+
+// NewMurmur3_x86_128Seed returns a new Hash128 object computing the Murmur3 x86 128-bit hash with the given seed
+func NewMurmur3_x86_128Seed(seed uint32) Hash128 {
+	m := new(murmur3x86_128)
+	m.seed = seed
+	m.Reset()
+	return m
+}
+
+func (m *murmur3x86_128) Size() int      { return 16 }
+func (m *murmur3x86_128) BlockSize() int { return 16 }
+func (m *murmur3x86_128) Reset() {
+	m.h1, m.h2, m.h3, m.h4 = m.seed, m.seed, m.seed, m.seed
+	m.length = 0
+	m.rem = 0
+}
+
+const (
+	c1_128 = uint32(0x239b961b)
+	c2_128 = uint32(0xab0e9789)
+	c3_128 = uint32(0x38b34ae5)
+	c4_128 = uint32(0xa1e38b93)
+)
+
+// computes new hash state merged with the 16 bytes in p
+func (m *murmur3x86_128) update(p []byte) {
+	k1 := binary.LittleEndian.Uint32(p[0:])
+	k2 := binary.LittleEndian.Uint32(p[4:])
+	k3 := binary.LittleEndian.Uint32(p[8:])
+	k4 := binary.LittleEndian.Uint32(p[12:])
+
+	k1 *= c1_128
+	k1 = rotl32(k1, 15)
+	k1 *= c2_128
+	m.h1 ^= k1
+
+	m.h1 = rotl32(m.h1, 19)
+	m.h1 += m.h2
+	m.h1 = m.h1*5 + 0x561ccd1b
+
+	k2 *= c2_128
+	k2 = rotl32(k2, 16)
+	k2 *= c3_128
+	m.h2 ^= k2
+
+	m.h2 = rotl32(m.h2, 17)
+	m.h2 += m.h3
+	m.h2 = m.h2*5 + 0x0bcaa747
+
+	k3 *= c3_128
+	k3 = rotl32(k3, 17)
+	k3 *= c4_128
+	m.h3 ^= k3
+
+	m.h3 = rotl32(m.h3, 15)
+	m.h3 += m.h4
+	m.h3 = m.h3*5 + 0x96cd1c35
+
+	k4 *= c4_128
+	k4 = rotl32(k4, 18)
+	k4 *= c1_128
+	m.h4 ^= k4
+
+	m.h4 = rotl32(m.h4, 13)
+	m.h4 += m.h1
+	m.h4 = m.h4*5 + 0x32ac3b17
+}
+
+func (m *murmur3x86_128) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+
+	m.length += uint32(datalen)
+
+	// As with murmur3, keep track of the tail bytes that haven't yet been
+	// processed, and do that on next round if we can scrounge together a
+	// full block.  If they're not merged here, they're pulled in during
+	// the finalize step
+	if m.rem != 0 {
+
+		n := copy(m.t[m.rem:], data)
+		m.rem += n
+
+		if m.rem < 16 {
+			return datalen, nil
+		}
+
+		m.update(m.t[:])
+
+		// nothing is left in the tail
+		m.rem = 0
+		data = data[n:]
+	}
+
+	length := len(data)
+
+	// figure out the length of the tail, and round down b
+	rem := length & 15
+	b := length - rem
+
+	for i := 0; i < b; i += 16 {
+		m.update(data[i:])
+	}
+
+	// copy the tail for later
+	copy(m.t[:rem], data[b:])
+
+	m.rem = rem
+
+	return datalen, nil
+}
+
+func (m *murmur3x86_128) Sum(b []byte) []byte {
+	h1, h2 := m.Sum128()
+	return appendSum128(b, h1, h2)
+}
+
+func (m *murmur3x86_128) Sum64() uint64 {
+	h1, _ := m.Sum128()
+	return h1
+}
+
+// murmur3 x86_128 finalize step.  The four 32-bit words of the hash are
+// returned packed little-endian into two uint64s, so that the bytes match
+// the output of the reference implementation.
+func (m *murmur3x86_128) Sum128() (uint64, uint64) {
+
+	var k1, k2, k3, k4 uint32
+
+	// copy so as not to change the internal state
+	h1, h2, h3, h4 := m.h1, m.h2, m.h3, m.h4
+
+	t := m.t
+
+	switch m.rem {
+	case 15:
+		k4 ^= uint32(t[14]) << 16
+		fallthrough
+	case 14:
+		k4 ^= uint32(t[13]) << 8
+		fallthrough
+	case 13:
+		k4 ^= uint32(t[12])
+		k4 *= c4_128
+		k4 = rotl32(k4, 18)
+		k4 *= c1_128
+		h4 ^= k4
+		fallthrough
+	case 12:
+		k3 ^= uint32(t[11]) << 24
+		fallthrough
+	case 11:
+		k3 ^= uint32(t[10]) << 16
+		fallthrough
+	case 10:
+		k3 ^= uint32(t[9]) << 8
+		fallthrough
+	case 9:
+		k3 ^= uint32(t[8])
+		k3 *= c3_128
+		k3 = rotl32(k3, 17)
+		k3 *= c4_128
+		h3 ^= k3
+		fallthrough
+	case 8:
+		k2 ^= uint32(t[7]) << 24
+		fallthrough
+	case 7:
+		k2 ^= uint32(t[6]) << 16
+		fallthrough
+	case 6:
+		k2 ^= uint32(t[5]) << 8
+		fallthrough
+	case 5:
+		k2 ^= uint32(t[4])
+		k2 *= c2_128
+		k2 = rotl32(k2, 16)
+		k2 *= c3_128
+		h2 ^= k2
+		fallthrough
+	case 4:
+		k1 ^= uint32(t[3]) << 24
+		fallthrough
+	case 3:
+		k1 ^= uint32(t[2]) << 16
+		fallthrough
+	case 2:
+		k1 ^= uint32(t[1]) << 8
+		fallthrough
+	case 1:
+		k1 ^= uint32(t[0])
+		k1 *= c1_128
+		k1 = rotl32(k1, 15)
+		k1 *= c2_128
+		h1 ^= k1
+	}
+
+	h1 ^= m.length
+	h2 ^= m.length
+	h3 ^= m.length
+	h4 ^= m.length
+
+	h1 += h2 + h3 + h4
+	h2 += h1
+	h3 += h1
+	h4 += h1
+
+	h1 = fmix32(h1)
+	h2 = fmix32(h2)
+	h3 = fmix32(h3)
+	h4 = fmix32(h4)
+
+	h1 += h2 + h3 + h4
+	h2 += h1
+	h3 += h1
+	h4 += h1
+
+	return uint64(h2)<<32 | uint64(h1), uint64(h4)<<32 | uint64(h3)
+}
+
+type murmur3x64_128 struct {
+	seed   uint32
+	h1, h2 uint64   // our hash state
+	length uint64   // current bytes written so far (needed for finalize)
+	t      [16]byte // as-yet-unprocessed bytes
+	rem    int      // how many bytes in t[] are valid
+}
+
+// NewMurmur3_x64_128 returns a new Hash128 object computing the Murmur3 x64 128-bit hash
+func NewMurmur3_x64_128() Hash128 {
+	return NewMurmur3_x64_128Seed(0)
+}
+
+// NewMurmur3_x64_128Seed returns a new Hash128 object computing the Murmur3 x64 128-bit hash with the given seed
+func NewMurmur3_x64_128Seed(seed uint32) Hash128 {
+	m := new(murmur3x64_128)
+	m.seed = seed
+	m.Reset()
+	return m
+}
+
+func (m *murmur3x64_128) Size() int      { return 16 }
+func (m *murmur3x64_128) BlockSize() int { return 16 }
+func (m *murmur3x64_128) Reset() {
+	m.h1, m.h2 = uint64(m.seed), uint64(m.seed)
+	m.length = 0
+	m.rem = 0
+}
+
+const (
+	c1_64 = uint64(0x87c37b91114253d5)
+	c2_64 = uint64(0x4cf5ad432745937f)
+)
+
+// computes new hash state merged with the 16 bytes in p
+func (m *murmur3x64_128) update(p []byte) {
+	k1 := binary.LittleEndian.Uint64(p[0:])
+	k2 := binary.LittleEndian.Uint64(p[8:])
+
+	k1 *= c1_64
+	k1 = rotl64(k1, 31)
+	k1 *= c2_64
+	m.h1 ^= k1
+
+	m.h1 = rotl64(m.h1, 27)
+	m.h1 += m.h2
+	m.h1 = m.h1*5 + 0x52dce729
+
+	k2 *= c2_64
+	k2 = rotl64(k2, 33)
+	k2 *= c1_64
+	m.h2 ^= k2
+
+	m.h2 = rotl64(m.h2, 31)
+	m.h2 += m.h1
+	m.h2 = m.h2*5 + 0x38495ab5
+}
+
+// virtually identical to murmur3x86_128:Write()
+func (m *murmur3x64_128) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+
+	m.length += uint64(datalen)
+
+	if m.rem != 0 {
+
+		n := copy(m.t[m.rem:], data)
+		m.rem += n
+
+		if m.rem < 16 {
+			return datalen, nil
+		}
+
+		m.update(m.t[:])
+
+		// nothing is left in the tail
+		m.rem = 0
+		data = data[n:]
+	}
+
+	length := len(data)
+
+	// figure out the length of the tail, and round down b
+	rem := length & 15
+	b := length - rem
+
+	for i := 0; i < b; i += 16 {
+		m.update(data[i:])
+	}
+
+	// copy the tail for later
+	copy(m.t[:rem], data[b:])
+
+	m.rem = rem
+
+	return datalen, nil
+}
+
+func (m *murmur3x64_128) Sum(b []byte) []byte {
+	h1, h2 := m.Sum128()
+	return appendSum128(b, h1, h2)
+}
+
+func (m *murmur3x64_128) Sum64() uint64 {
+	h1, _ := m.Sum128()
+	return h1
+}
+
+// murmur3 x64_128 finalize step
+func (m *murmur3x64_128) Sum128() (uint64, uint64) {
+
+	var k1, k2 uint64
+
+	// copy so as not to change the internal state
+	h1, h2 := m.h1, m.h2
+
+	t := m.t
+
+	switch m.rem {
+	case 15:
+		k2 ^= uint64(t[14]) << 48
+		fallthrough
+	case 14:
+		k2 ^= uint64(t[13]) << 40
+		fallthrough
+	case 13:
+		k2 ^= uint64(t[12]) << 32
+		fallthrough
+	case 12:
+		k2 ^= uint64(t[11]) << 24
+		fallthrough
+	case 11:
+		k2 ^= uint64(t[10]) << 16
+		fallthrough
+	case 10:
+		k2 ^= uint64(t[9]) << 8
+		fallthrough
+	case 9:
+		k2 ^= uint64(t[8])
+		k2 *= c2_64
+		k2 = rotl64(k2, 33)
+		k2 *= c1_64
+		h2 ^= k2
+		fallthrough
+	case 8:
+		k1 ^= uint64(t[7]) << 56
+		fallthrough
+	case 7:
+		k1 ^= uint64(t[6]) << 48
+		fallthrough
+	case 6:
+		k1 ^= uint64(t[5]) << 40
+		fallthrough
+	case 5:
+		k1 ^= uint64(t[4]) << 32
+		fallthrough
+	case 4:
+		k1 ^= uint64(t[3]) << 24
+		fallthrough
+	case 3:
+		k1 ^= uint64(t[2]) << 16
+		fallthrough
+	case 2:
+		k1 ^= uint64(t[1]) << 8
+		fallthrough
+	case 1:
+		k1 ^= uint64(t[0])
+		k1 *= c1_64
+		k1 = rotl64(k1, 31)
+		k1 *= c2_64
+		h1 ^= k1
+	}
+
+	h1 ^= m.length
+	h2 ^= m.length
+
+	h1 += h2
+	h2 += h1
+
+	h1 = fmix64(h1)
+	h2 = fmix64(h2)
+
+	h1 += h2
+	h2 += h1
+
+	return h1, h2
+}
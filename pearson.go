@@ -0,0 +1,113 @@
+// This file is an implementation of Pearson hashing by Peter K. Pearson
+// The algorithm is described in "Fast Hashing of Variable-Length Text Strings", CACM 33(6), 1990
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// Pearson's hash produces 8 bits.  Wider hashes are built by running the
+// table once per output byte, adding the byte's position to the first byte of
+// the input.  The first lane is the plain 8-bit hash, and is the most
+// significant byte of the result.  The empty input hashes to zero.
+
+package dgohash
+
+import (
+	"hash"
+)
+
+type pearson struct {
+	table   [256]byte // the permutation
+	size    int       // output size in bytes, 1, 2, 4 or 8
+	h       [8]byte   // our hash state, one byte per lane
+	started bool      // whether the first byte of the input has been seen
+}
+
+func newPearson(table [256]byte, size int) *pearson {
+	return &pearson{table: table, size: size}
+}
+
+// NewPearson8 returns a new hash.Hash32 object computing the 8-bit Pearson hash with the given permutation of 0..255.
+// Only the low 8 bits of Sum32 are significant, the rest being zero, and Size is 1: Sum appends a single byte.
+func NewPearson8(table [256]byte) hash.Hash32 {
+	return newPearson(table, 1)
+}
+
+// NewPearson16 returns a new hash.Hash32 object computing the 16-bit Pearson hash with the given permutation of 0..255.
+// Only the low 16 bits of Sum32 are significant, the rest being zero, and Size is 2: Sum appends two bytes.
+func NewPearson16(table [256]byte) hash.Hash32 {
+	return newPearson(table, 2)
+}
+
+// NewPearson32 returns a new hash.Hash32 object computing the 32-bit Pearson hash with the given permutation of 0..255
+func NewPearson32(table [256]byte) hash.Hash32 {
+	return newPearson(table, 4)
+}
+
+// NewPearson64 returns a new hash.Hash64 object computing the 64-bit Pearson hash with the given permutation of 0..255
+func NewPearson64(table [256]byte) hash.Hash64 {
+	return newPearson(table, 8)
+}
+
+// PearsonTable returns a permutation of 0..255 for use with the Pearson hashes, generated deterministically from seed.
+// The table is a Fisher-Yates shuffle driven by splitmix64.
+func PearsonTable(seed uint64) [256]byte {
+
+	var t [256]byte
+
+	for i := range t {
+		t[i] = byte(i)
+	}
+
+	for i := 255; i > 0; i-- {
+		j := splitmix64(&seed) % uint64(i+1)
+		t[i], t[j] = t[j], t[i]
+	}
+
+	return t
+}
+
+func (p *pearson) Size() int      { return p.size }
+func (p *pearson) BlockSize() int { return 1 }
+func (p *pearson) Reset()         { p.h = [8]byte{}; p.started = false }
+
+func (p *pearson) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+
+	if datalen == 0 {
+		return 0, nil
+	}
+
+	h := p.h[:p.size]
+
+	if !p.started {
+		for j := range h {
+			h[j] = p.table[data[0]+byte(j)]
+		}
+		p.started = true
+		data = data[1:]
+	}
+
+	for _, c := range data {
+		for j := range h {
+			h[j] = p.table[h[j]^c]
+		}
+	}
+
+	return datalen, nil
+}
+
+func (p *pearson) Sum(b []byte) []byte {
+	return append(b, p.h[:p.size]...)
+}
+
+func (p *pearson) Sum32() uint32 {
+	return uint32(p.Sum64())
+}
+
+func (p *pearson) Sum64() uint64 {
+	var v uint64
+	for _, h := range p.h[:p.size] {
+		v = v<<8 | uint64(h)
+	}
+	return v
+}
@@ -0,0 +1,70 @@
+// This file is an implementation of polynomial string hashing modulo the Mersenne prime 2^61-1
+// The algorithm is the Java string hash recurrence, h = h*base + c, with a random base and the arithmetic done modulo a prime
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// The hash of c1..cn is 1*r^n + c1*r^(n-1) + ... + cn + b mod 2^61-1, for a
+// random base r and offset b.  The leading 1 keeps strings of different
+// lengths apart, so two different strings of at most n bytes collide with
+// probability at most n/(2^61-1), and with the random offset the pair of
+// hashes is close to uniformly distributed.  These bounds only hold if the
+// input doesn't depend on the base, which is why NewPoly61 picks a fresh one
+// for each hash.
+
+package dgohash
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"hash"
+)
+
+type poly61 struct {
+	base uint64 // the evaluation point, in [1, 2^61-1)
+	b    uint64 // the offset added to the result
+	h    uint64 // our hash state
+}
+
+// NewPoly61 returns a new hash.Hash64 object computing the polynomial hash modulo 2^61-1 with a random base
+func NewPoly61() hash.Hash64 {
+	var seed [8]byte
+	if _, err := rand.Read(seed[:]); err != nil {
+		panic("dgohash: unable to read random seed: " + err.Error())
+	}
+	return NewPoly61Seed(binary.LittleEndian.Uint64(seed[:]))
+}
+
+// NewPoly61Seed returns a new hash.Hash64 object computing the polynomial hash modulo 2^61-1 with the base and offset chosen by seed
+func NewPoly61Seed(seed uint64) hash.Hash64 {
+	p := new(poly61)
+	p.base = newPolyPrehash(&seed).base
+	p.b = splitmix64(&seed) % mersenne61
+	p.Reset()
+	return p
+}
+
+func (p *poly61) Size() int      { return 8 }
+func (p *poly61) BlockSize() int { return 1 }
+func (p *poly61) Reset()         { p.h = 1 }
+
+func (p *poly61) Write(data []byte) (int, error) {
+
+	h := p.h
+
+	for _, c := range data {
+		h = addmod61(mulmod61(h, p.base), uint64(c))
+	}
+
+	p.h = h
+
+	return len(data), nil
+}
+
+func (p *poly61) Sum64() uint64 {
+	return addmod61(p.h, p.b)
+}
+
+func (p *poly61) Sum(b []byte) []byte {
+	v := p.Sum64()
+	return append(b, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
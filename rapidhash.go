@@ -0,0 +1,79 @@
+// This file is an implementation of rapidhash (version 1) by Nicolas De Carli
+// The code is translated from the BSD-licensed source code at https://github.com/Nicoshev/rapidhash
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// rapidhash is derived from wyhash, and shares its first three secrets.  As
+// with wyhash, the hash.Hash version buffers all its input.
+
+package dgohash
+
+import (
+	"hash"
+	"math/bits"
+)
+
+// RapidHashSeed is the seed used by the reference rapidhash() function
+const RapidHashSeed = 0xbdd89aa982704029
+
+var rapidSecret = [3]uint64{0x2d358dccaa6c78a5, 0x8bb84b93962eacc9, 0x4b33a62ed433d4a3}
+
+// NewRapidHash returns a new hash.Hash64 object computing rapidhash with the given seed
+func NewRapidHash(seed uint64) hash.Hash64 {
+	return &buffered64{f: func(data []byte) uint64 { return RapidHash(data, seed) }, blockSize: 48}
+}
+
+// the rapid_readSmall() function from the reference code
+func rapidReadSmall(p []byte, k int) uint64 {
+	return uint64(p[0])<<56 | uint64(p[k>>1])<<32 | uint64(p[k-1])
+}
+
+// RapidHash returns the rapidhash of data with the given seed.  The reference rapidhash() uses RapidHashSeed.
+func RapidHash(data []byte, seed uint64) uint64 {
+
+	p := data
+	length := len(data)
+
+	seed ^= mul128fold64(seed^rapidSecret[0], rapidSecret[1]) ^ uint64(length)
+
+	var a, b uint64
+
+	if length <= 16 {
+		if length >= 4 {
+			plast := length - 4
+			a = uint64(readLE32(p))<<32 | uint64(readLE32(p[plast:]))
+			delta := (length & 24) >> (length >> 3)
+			b = uint64(readLE32(p[delta:]))<<32 | uint64(readLE32(p[plast-delta:]))
+		} else if length > 0 {
+			a = rapidReadSmall(p, length)
+			b = 0
+		}
+	} else {
+		i := length
+		if i > 48 {
+			see1, see2 := seed, seed
+			for ; i >= 48; i -= 48 {
+				seed = mul128fold64(readLE64(p)^rapidSecret[0], readLE64(p[8:])^seed)
+				see1 = mul128fold64(readLE64(p[16:])^rapidSecret[1], readLE64(p[24:])^see1)
+				see2 = mul128fold64(readLE64(p[32:])^rapidSecret[2], readLE64(p[40:])^see2)
+				p = p[48:]
+			}
+			seed ^= see1 ^ see2
+		}
+		if i > 16 {
+			seed = mul128fold64(readLE64(p)^rapidSecret[2], readLE64(p[8:])^seed^rapidSecret[1])
+			if i > 32 {
+				seed = mul128fold64(readLE64(p[16:])^rapidSecret[2], readLE64(p[24:])^seed)
+			}
+		}
+		// the last 16 bytes of the input, which may overlap bytes already mixed in
+		a = readLE64(data[length-16:])
+		b = readLE64(data[length-8:])
+	}
+
+	a ^= rapidSecret[1]
+	b ^= seed
+	b, a = bits.Mul64(a, b)
+
+	return mul128fold64(a^rapidSecret[0]^uint64(length), b^rapidSecret[1])
+}
@@ -0,0 +1,347 @@
+// This file contains a registry of the hashes in this package, so they can be created by name.
+// Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version.
+
+// The names of the built-in hashes are stable; List returns them all.  Hashes
+// are seeded with WithSeed or WithSeeds: most seeded hashes take one seed,
+// SipHash takes two (its key), HighwayHash four, and SpookyHash and
+// CityHash one or two.  The rolling hashes need WithWindow, and the rolling
+// Java and djb2 hashes take no seed.  A hash that is not given a seed uses
+// zero, or the default seed of its reference code.
+
+package dgohash
+
+import (
+	"errors"
+	"fmt"
+	"hash"
+	"sort"
+	"sync"
+)
+
+// Options holds the settings collected from the options passed to New
+type Options struct {
+	Seeds  []uint64 // the seeds, in order, if any were given
+	Window int      // the window size for the rolling hashes, or 0 if not given
+}
+
+// Option sets one of the Options for New
+type Option func(*Options)
+
+// WithSeed seeds the hash
+func WithSeed(seed uint64) Option {
+	return func(o *Options) { o.Seeds = []uint64{seed} }
+}
+
+// WithSeeds seeds a hash that takes more than one seed or key word
+func WithSeeds(seeds ...uint64) Option {
+	return func(o *Options) { o.Seeds = append([]uint64(nil), seeds...) }
+}
+
+// WithWindow sets the window size of a rolling hash
+func WithWindow(window int) Option {
+	return func(o *Options) { o.Window = window }
+}
+
+// Constructor creates a hash with the given options, returning an error if they don't apply to it
+type Constructor func(o Options) (hash.Hash, error)
+
+var (
+	registryMu sync.RWMutex
+	registry   = make(map[string]Constructor)
+)
+
+// Register makes a hash available to New under the given name.
+// It panics if the name is already registered or f is nil.
+func Register(name string, f Constructor) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if f == nil {
+		panic("dgohash: Register constructor is nil")
+	}
+	if _, dup := registry[name]; dup {
+		panic("dgohash: Register called twice for " + name)
+	}
+	registry[name] = f
+}
+
+// New returns a new hash.Hash computing the hash registered under name
+func New(name string, opts ...Option) (hash.Hash, error) {
+
+	registryMu.RLock()
+	f, ok := registry[name]
+	registryMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("dgohash: unknown hash %q", name)
+	}
+
+	var o Options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	h, err := f(o)
+	if err != nil {
+		return nil, fmt.Errorf("dgohash: %s: %v", name, err)
+	}
+
+	return h, nil
+}
+
+// List returns the sorted names of the registered hashes
+func List() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+var (
+	errNoSeed    = errors.New("takes no seed")
+	errNoWindow  = errors.New("takes no window size")
+	errNeedsSize = errors.New("needs a window size")
+)
+
+// check that at most n seeds were given, and a window size only if window is true
+func checkOptions(o Options, n int, window bool) error {
+	switch {
+	case len(o.Seeds) > n && n == 0:
+		return errNoSeed
+	case len(o.Seeds) > n:
+		return fmt.Errorf("takes at most %d seeds, got %d", n, len(o.Seeds))
+	case o.Window != 0 && !window:
+		return errNoWindow
+	case o.Window <= 0 && window:
+		return errNeedsSize
+	}
+	return nil
+}
+
+// seed returns the i'th seed, or def if it wasn't given
+func (o Options) seed(i int, def uint64) uint64 {
+	if i < len(o.Seeds) {
+		return o.Seeds[i]
+	}
+	return def
+}
+
+func unseeded(f func() hash.Hash) Constructor {
+	return func(o Options) (hash.Hash, error) {
+		if err := checkOptions(o, 0, false); err != nil {
+			return nil, err
+		}
+		return f(), nil
+	}
+}
+
+func seeded32(f func(seed uint32) hash.Hash) Constructor {
+	return func(o Options) (hash.Hash, error) {
+		if err := checkOptions(o, 1, false); err != nil {
+			return nil, err
+		}
+		seed := o.seed(0, 0)
+		if seed > 0xffffffff {
+			return nil, fmt.Errorf("seed 0x%x doesn't fit in 32 bits", seed)
+		}
+		return f(uint32(seed)), nil
+	}
+}
+
+func seeded64(def uint64, f func(seed uint64) hash.Hash) Constructor {
+	return func(o Options) (hash.Hash, error) {
+		if err := checkOptions(o, 1, false); err != nil {
+			return nil, err
+		}
+		return f(o.seed(0, def)), nil
+	}
+}
+
+// for hashes whose key is two 64-bit words
+func keyed128(f func(k0, k1 uint64) hash.Hash) Constructor {
+	return func(o Options) (hash.Hash, error) {
+		if err := checkOptions(o, 2, false); err != nil {
+			return nil, err
+		}
+		return f(o.seed(0, 0), o.seed(1, 0)), nil
+	}
+}
+
+func highway(f func(key [4]uint64) hash.Hash) Constructor {
+	return func(o Options) (hash.Hash, error) {
+		if err := checkOptions(o, 4, false); err != nil {
+			return nil, err
+		}
+		return f([4]uint64{o.seed(0, 0), o.seed(1, 0), o.seed(2, 0), o.seed(3, 0)}), nil
+	}
+}
+
+// for the seeded rolling hashes, whose window can be at most max bytes, or any size if max is 0
+func rolling(max int, f func(window int, seed uint64) hash.Hash) Constructor {
+	return func(o Options) (hash.Hash, error) {
+		if err := checkOptions(o, 1, true); err != nil {
+			return nil, err
+		}
+		if max != 0 && o.Window > max {
+			return nil, fmt.Errorf("window size %d is larger than %d", o.Window, max)
+		}
+		return f(o.Window, o.seed(0, 0)), nil
+	}
+}
+
+// for the rolling hashes that take no seed
+func windowed(f func(window int) hash.Hash) Constructor {
+	return func(o Options) (hash.Hash, error) {
+		if err := checkOptions(o, 0, true); err != nil {
+			return nil, err
+		}
+		return f(o.Window), nil
+	}
+}
+
+func init() {
+
+	builtins := map[string]Constructor{
+		"java":          unseeded(func() hash.Hash { return NewJava32() }),
+		"java64":        unseeded(func() hash.Hash { return NewJava64() }),
+		"djb2":          unseeded(func() hash.Hash { return NewDjb32() }),
+		"djb2_64":       unseeded(func() hash.Hash { return NewDjb64() }),
+		"djb2a":         unseeded(func() hash.Hash { return NewDjb32a() }),
+		"djb2a_64":      unseeded(func() hash.Hash { return NewDjb64a() }),
+		"elf32":         unseeded(func() hash.Hash { return NewElf32() }),
+		"sdbm":          unseeded(func() hash.Hash { return NewSDBM32() }),
+		"sdbm64":        unseeded(func() hash.Hash { return NewSDBM64() }),
+		"sqlite":        unseeded(func() hash.Hash { return NewSQLite32() }),
+		"jenkins_oaat":  unseeded(func() hash.Hash { return NewJenkins32() }),
+		"rs":            unseeded(func() hash.Hash { return NewRS32() }),
+		"js":            unseeded(func() hash.Hash { return NewJS32() }),
+		"pjw":           unseeded(func() hash.Hash { return NewPJW32() }),
+		"bkdr":          unseeded(func() hash.Hash { return NewBKDR32() }),
+		"dek":           unseeded(func() hash.Hash { return NewDEK32() }),
+		"bp":            unseeded(func() hash.Hash { return NewBP32() }),
+		"ap":            unseeded(func() hash.Hash { return NewAP32() }),
+		"kr1":           unseeded(func() hash.Hash { return NewKR1_32() }),
+		"kr2":           unseeded(func() hash.Hash { return NewKR2_32() }),
+		"superfasthash": unseeded(func() hash.Hash { return NewSuperFastHash() }),
+
+		"murmur2":         seeded32(func(seed uint32) hash.Hash { return NewMurmur2(seed) }),
+		"murmur2a":        seeded32(func(seed uint32) hash.Hash { return NewMurmur2A(seed) }),
+		"murmur2_neutral": seeded32(func(seed uint32) hash.Hash { return NewMurmurNeutral2(seed) }),
+		"murmur2_aligned": seeded32(func(seed uint32) hash.Hash { return NewMurmurAligned2(seed) }),
+		"murmur64a":       seeded64(0, func(seed uint64) hash.Hash { return NewMurmur64A(seed) }),
+		"murmur64b":       seeded64(0, func(seed uint64) hash.Hash { return NewMurmur64B(seed) }),
+		"murmur3_x86_32":  seeded32(func(seed uint32) hash.Hash { return NewMurmur3_x86_32Seed(seed) }),
+		"murmur3_x86_128": seeded32(func(seed uint32) hash.Hash { return NewMurmur3_x86_128Seed(seed) }),
+		"murmur3_x64_128": seeded32(func(seed uint32) hash.Hash { return NewMurmur3_x64_128Seed(seed) }),
+
+		"marvin32": seeded64(0, func(seed uint64) hash.Hash { return NewMarvin32(seed) }),
+		"marvin64": seeded64(0, func(seed uint64) hash.Hash { return NewMarvin64(seed) }),
+
+		"xxh32":    seeded32(func(seed uint32) hash.Hash { return NewXXH32(seed) }),
+		"xxh64":    seeded64(0, func(seed uint64) hash.Hash { return NewXXH64(seed) }),
+		"xxh3_64":  seeded64(0, func(seed uint64) hash.Hash { return NewXXH3_64Seed(seed) }),
+		"xxh3_128": seeded64(0, func(seed uint64) hash.Hash { return NewXXH3_128Seed(seed) }),
+
+		"siphash24":     keyed128(func(k0, k1 uint64) hash.Hash { return NewSipHash24(k0, k1) }),
+		"siphash13":     keyed128(func(k0, k1 uint64) hash.Hash { return NewSipHash13(k0, k1) }),
+		"siphash24_128": keyed128(func(k0, k1 uint64) hash.Hash { return NewSipHash24_128(k0, k1) }),
+		"siphash13_128": keyed128(func(k0, k1 uint64) hash.Hash { return NewSipHash13_128(k0, k1) }),
+
+		"highwayhash64":  highway(func(key [4]uint64) hash.Hash { return NewHighwayHash64(key) }),
+		"highwayhash128": highway(func(key [4]uint64) hash.Hash { return NewHighwayHash128(key) }),
+		"highwayhash256": highway(func(key [4]uint64) hash.Hash { return NewHighwayHash256(key) }),
+
+		"cityhash32": unseeded(func() hash.Hash { return NewCityHash32() }),
+		"cityhash64": func(o Options) (hash.Hash, error) {
+			if err := checkOptions(o, 2, false); err != nil {
+				return nil, err
+			}
+			switch len(o.Seeds) {
+			case 0:
+				return NewCityHash64(), nil
+			case 1:
+				return NewCityHash64Seed(o.Seeds[0]), nil
+			}
+			return NewCityHash64Seeds(o.Seeds[0], o.Seeds[1]), nil
+		},
+		"cityhash128": func(o Options) (hash.Hash, error) {
+			if err := checkOptions(o, 2, false); err != nil {
+				return nil, err
+			}
+			if len(o.Seeds) == 0 {
+				return NewCityHash128(), nil
+			}
+			return NewCityHash128Seed(o.seed(0, 0), o.seed(1, 0)), nil
+		},
+
+		"farm_fingerprint32":  unseeded(func() hash.Hash { return NewFarmFingerprint32() }),
+		"farm_fingerprint64":  unseeded(func() hash.Hash { return NewFarmFingerprint64() }),
+		"farm_fingerprint128": unseeded(func() hash.Hash { return NewFarmFingerprint128() }),
+
+		// one seed is used for both halves of the state, as SpookyHash64 does
+		"spookyhash": func(o Options) (hash.Hash, error) {
+			if err := checkOptions(o, 2, false); err != nil {
+				return nil, err
+			}
+			seed1 := o.seed(0, 0)
+			return NewSpookyHash(seed1, o.seed(1, seed1)), nil
+		},
+
+		"lookup2":    seeded32(func(seed uint32) hash.Hash { return NewJenkinsLookup2(seed) }),
+		"hashlittle": seeded32(func(seed uint32) hash.Hash { return NewJenkinsHashLittle(seed) }),
+		"hashlittle2": func(o Options) (hash.Hash, error) {
+			if err := checkOptions(o, 2, false); err != nil {
+				return nil, err
+			}
+			pc, pb := o.seed(0, 0), o.seed(1, 0)
+			if pc > 0xffffffff || pb > 0xffffffff {
+				return nil, errors.New("seeds must fit in 32 bits")
+			}
+			return NewJenkinsHashLittle2(uint32(pc), uint32(pb)), nil
+		},
+		"hashbig": seeded32(func(seed uint32) hash.Hash { return NewJenkinsHashBig(seed) }),
+
+		// the seed chooses the permutation, with PearsonTable
+		"pearson8":  seeded64(0, func(seed uint64) hash.Hash { return NewPearson8(PearsonTable(seed)) }),
+		"pearson16": seeded64(0, func(seed uint64) hash.Hash { return NewPearson16(PearsonTable(seed)) }),
+		"pearson32": seeded64(0, func(seed uint64) hash.Hash { return NewPearson32(PearsonTable(seed)) }),
+		"pearson64": seeded64(0, func(seed uint64) hash.Hash { return NewPearson64(PearsonTable(seed)) }),
+
+		"wyhash":    seeded64(0, func(seed uint64) hash.Hash { return NewWyHash(seed) }),
+		"rapidhash": seeded64(RapidHashSeed, func(seed uint64) hash.Hash { return NewRapidHash(seed) }),
+
+		// without a seed, each hash gets a random base
+		"poly61": func(o Options) (hash.Hash, error) {
+			if err := checkOptions(o, 1, false); err != nil {
+				return nil, err
+			}
+			if len(o.Seeds) == 0 {
+				return NewPoly61(), nil
+			}
+			return NewPoly61Seed(o.Seeds[0]), nil
+		},
+
+		"buzhash32":    rolling(0, func(window int, seed uint64) hash.Hash { return NewBuzhash32(window, seed) }),
+		"buzhash64":    rolling(0, func(window int, seed uint64) hash.Hash { return NewBuzhash64(window, seed) }),
+		"gear32":       rolling(32, func(window int, seed uint64) hash.Hash { return NewGear32(window, seed) }),
+		"gear64":       rolling(64, func(window int, seed uint64) hash.Hash { return NewGear64(window, seed) }),
+		"rolling_java": windowed(func(window int) hash.Hash { return NewRollingJava32(window) }),
+		"rolling_djb2": windowed(func(window int) hash.Hash { return NewRollingDjb32(window) }),
+
+		"fletcher16": unseeded(func() hash.Hash { return NewFletcher16() }),
+		"fletcher32": unseeded(func() hash.Hash { return NewFletcher32() }),
+		"fletcher64": unseeded(func() hash.Hash { return NewFletcher64() }),
+		"adler32":    unseeded(func() hash.Hash { return NewAdler32() }),
+		"bsd_sum":    unseeded(func() hash.Hash { return NewBSDSum() }),
+		"sysv_sum":   unseeded(func() hash.Hash { return NewSysVSum() }),
+	}
+
+	for name, f := range builtins {
+		Register(name, f)
+	}
+}
@@ -0,0 +1,101 @@
+// This file contains rolling window versions of the Java and djb2 string hashes.
+// Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version.
+
+// Both hashes are polynomials: after k bytes the hash is
+// init*B^k + c1*B^(k-1) + ... + ck, mod 2^32.  A byte is removed from the
+// start of the window by subtracting its term and dividing the init term by
+// B, which (B being odd) is a multiplication by B's inverse mod 2^32.
+
+package dgohash
+
+// RollingWindowHash32 is a RollingHash32 whose window can also be grown and shrunk a byte at a time.
+// Push adds in to the end of the window, and Pop removes out from its start.
+type RollingWindowHash32 interface {
+	RollingHash32
+	Push(in byte)
+	Pop(out byte)
+}
+
+type rollingPoly32 struct {
+	base, init uint32
+	baseInv    uint32 // base^-1 mod 2^32
+	baseWindow uint32 // base^window
+	h          uint32 // our hash state
+	pow        uint32 // base^(bytes in the window)
+}
+
+// NewRollingJava32 returns a new RollingWindowHash32 object computing Java's string.hashCode() over a window of the given length.
+// Write and Push add bytes to the window, Pop removes them, and Roll moves a full window along by one.
+// The hash always equals that of NewJava32 over the bytes in the window.
+func NewRollingJava32(window int) RollingWindowHash32 {
+	return newRollingPoly32(31, 0, window)
+}
+
+// NewRollingDjb32 returns a new RollingWindowHash32 object computing Daniel J. Bernstein's hash over a window of the given length.
+// The hash always equals that of NewDjb32 over the bytes in the window.
+func NewRollingDjb32(window int) RollingWindowHash32 {
+	return newRollingPoly32(33, 5381, window)
+}
+
+func newRollingPoly32(base, init uint32, window int) *rollingPoly32 {
+
+	if window < 1 {
+		panic("dgohash: rolling window size must be positive")
+	}
+
+	r := &rollingPoly32{base: base, init: init}
+
+	// Newton's iteration doubles the number of correct low bits each time
+	r.baseInv = base
+	for i := 0; i < 4; i++ {
+		r.baseInv *= 2 - base*r.baseInv
+	}
+
+	r.baseWindow = pow32(base, int64(window))
+
+	r.Reset()
+	return r
+}
+
+func (r *rollingPoly32) Size() int      { return 4 }
+func (r *rollingPoly32) BlockSize() int { return 1 }
+func (r *rollingPoly32) Reset()         { r.h = r.init; r.pow = 1 }
+
+func (r *rollingPoly32) Write(data []byte) (int, error) {
+
+	h, pow := r.h, r.pow
+
+	for _, c := range data {
+		h = r.base*h + uint32(c)
+		pow *= r.base
+	}
+
+	r.h, r.pow = h, pow
+
+	return len(data), nil
+}
+
+func (r *rollingPoly32) Push(in byte) {
+	r.h = r.base*r.h + uint32(in)
+	r.pow *= r.base
+}
+
+func (r *rollingPoly32) Pop(out byte) {
+	r.pow *= r.baseInv
+	r.h -= (uint32(out) + r.init*(r.base-1)) * r.pow
+}
+
+// Roll is Pop(out) followed by Push(in), for a window holding exactly window bytes
+func (r *rollingPoly32) Roll(out, in byte) {
+	r.h = r.base*r.h + uint32(in) - (uint32(out)+r.init*(r.base-1))*r.baseWindow
+}
+
+func (r *rollingPoly32) Sum32() uint32 {
+	return r.h
+}
+
+func (r *rollingPoly32) Sum(b []byte) []byte {
+	v := r.h
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
@@ -0,0 +1,209 @@
+// This file is an implementation of the SipHash keyed hash functions by Jean-Philippe Aumasson and Daniel J. Bernstein
+// The code is translated from the public domain reference code at https://github.com/veorq/SipHash
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// SipHash-2-4 is the original recommended parameter set.  SipHash-1-3 trades
+// some security margin for speed and is what many hash table
+// implementations use.
+
+package dgohash
+
+import (
+	"hash"
+)
+
+type siphash struct {
+	k0, k1         uint64
+	c, d           int     // compression and finalization rounds
+	size           int     // output size in bytes, 8 or 16
+	v0, v1, v2, v3 uint64  // our hash state
+	length         uint64  // current bytes written so far (needed for finalize)
+	t              [8]byte // as-yet-unprocessed bytes
+	rem            int     // how many bytes in t[] are valid
+}
+
+func newSipHash(k0, k1 uint64, c, d, size int) *siphash {
+	s := &siphash{k0: k0, k1: k1, c: c, d: d, size: size}
+	s.Reset()
+	return s
+}
+
+// NewSipHash24 returns a new hash.Hash64 object computing SipHash-2-4 keyed
+// with k0 and k1, the little-endian halves of the 128-bit key
+func NewSipHash24(k0, k1 uint64) hash.Hash64 {
+	return newSipHash(k0, k1, 2, 4, 8)
+}
+
+// NewSipHash13 returns a new hash.Hash64 object computing SipHash-1-3 keyed
+// with k0 and k1, the little-endian halves of the 128-bit key
+func NewSipHash13(k0, k1 uint64) hash.Hash64 {
+	return newSipHash(k0, k1, 1, 3, 8)
+}
+
+// NewSipHash24_128 returns a new Hash128 object computing the 128-bit output variant of SipHash-2-4
+func NewSipHash24_128(k0, k1 uint64) Hash128 {
+	return newSipHash(k0, k1, 2, 4, 16)
+}
+
+// NewSipHash13_128 returns a new Hash128 object computing the 128-bit output variant of SipHash-1-3
+func NewSipHash13_128(k0, k1 uint64) Hash128 {
+	return newSipHash(k0, k1, 1, 3, 16)
+}
+
+func (s *siphash) Size() int      { return s.size }
+func (s *siphash) BlockSize() int { return 8 }
+func (s *siphash) Reset() {
+	s.v0 = s.k0 ^ 0x736f6d6570736575
+	s.v1 = s.k1 ^ 0x646f72616e646f6d
+	s.v2 = s.k0 ^ 0x6c7967656e657261
+	s.v3 = s.k1 ^ 0x7465646279746573
+	if s.size == 16 {
+		s.v1 ^= 0xee
+	}
+	s.length = 0
+	s.rem = 0
+}
+
+func (s *siphash) rounds(n int) {
+	v0, v1, v2, v3 := s.v0, s.v1, s.v2, s.v3
+	for i := 0; i < n; i++ {
+		v0 += v1
+		v1 = rotl64(v1, 13)
+		v1 ^= v0
+		v0 = rotl64(v0, 32)
+		v2 += v3
+		v3 = rotl64(v3, 16)
+		v3 ^= v2
+		v0 += v3
+		v3 = rotl64(v3, 21)
+		v3 ^= v0
+		v2 += v1
+		v1 = rotl64(v1, 17)
+		v1 ^= v2
+		v2 = rotl64(v2, 32)
+	}
+	s.v0, s.v1, s.v2, s.v3 = v0, v1, v2, v3
+}
+
+// computes new hash state merged with the message word m
+func (s *siphash) update(m uint64) {
+	s.v3 ^= m
+	s.rounds(s.c)
+	s.v0 ^= m
+}
+
+func (s *siphash) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+
+	s.length += uint64(datalen)
+
+	// As with murmur3, keep track of the tail bytes that haven't yet been
+	// processed, and do that on next round if we can scrounge together a
+	// uint64.  If they're not merged here, they're pulled in during the
+	// finalize step
+	if s.rem != 0 {
+
+		n := copy(s.t[s.rem:], data)
+		s.rem += n
+
+		if s.rem < 8 {
+			return datalen, nil
+		}
+
+		s.update(readLE64(s.t[:]))
+
+		// nothing is left in the tail
+		s.rem = 0
+		data = data[n:]
+	}
+
+	length := len(data)
+
+	// figure out the length of the tail, and round down b
+	rem := length & 7
+	b := length - rem
+
+	for i := 0; i < b; i += 8 {
+		s.update(readLE64(data[i:]))
+	}
+
+	// copy the tail for later
+	copy(s.t[:rem], data[b:])
+
+	s.rem = rem
+
+	return datalen, nil
+}
+
+func (s *siphash) Sum(b []byte) []byte {
+	if s.size == 16 {
+		h1, h2 := s.Sum128()
+		return appendSum128(b, h1, h2)
+	}
+	h := s.Sum64()
+	return append(b, byte(h>>56), byte(h>>48), byte(h>>40), byte(h>>32), byte(h>>24), byte(h>>16), byte(h>>8), byte(h))
+}
+
+// Sum64 returns the 64-bit hash, or the first 64 bits of the 128-bit hash
+func (s *siphash) Sum64() uint64 {
+	h1, _ := s.Sum128()
+	return h1
+}
+
+// siphash finalize step.  For the 64-bit variants the second value is zero.
+func (s *siphash) Sum128() (uint64, uint64) {
+
+	// copy so as not to change the internal state
+	tmp := *s
+
+	b := tmp.length << 56
+
+	switch tmp.rem {
+	case 7:
+		b |= uint64(tmp.t[6]) << 48
+		fallthrough
+	case 6:
+		b |= uint64(tmp.t[5]) << 40
+		fallthrough
+	case 5:
+		b |= uint64(tmp.t[4]) << 32
+		fallthrough
+	case 4:
+		b |= uint64(tmp.t[3]) << 24
+		fallthrough
+	case 3:
+		b |= uint64(tmp.t[2]) << 16
+		fallthrough
+	case 2:
+		b |= uint64(tmp.t[1]) << 8
+		fallthrough
+	case 1:
+		b |= uint64(tmp.t[0])
+	}
+
+	tmp.update(b)
+
+	if tmp.size == 16 {
+		tmp.v2 ^= 0xee
+	} else {
+		tmp.v2 ^= 0xff
+	}
+
+	tmp.rounds(tmp.d)
+
+	h1 := tmp.v0 ^ tmp.v1 ^ tmp.v2 ^ tmp.v3
+
+	if tmp.size == 8 {
+		return h1, 0
+	}
+
+	tmp.v1 ^= 0xdd
+
+	tmp.rounds(tmp.d)
+
+	h2 := tmp.v0 ^ tmp.v1 ^ tmp.v2 ^ tmp.v3
+
+	return h1, h2
+}
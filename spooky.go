@@ -0,0 +1,480 @@
+// This file is an implementation of SpookyHash V2 by Bob Jenkins
+// The code is translated from the public domain source code at http://burtleburtle.net/bob/hash/spooky.html
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// Messages shorter than 192 bytes are hashed with a separate, cheaper
+// function, so the incremental hash keeps up to 192 bytes buffered until it
+// knows which of the two paths the message will take.
+
+package dgohash
+
+const (
+	spookyNumVars   = 12                  // number of uint64s in the internal state
+	spookyBlockSize = spookyNumVars * 8   // size of the internal state
+	spookyBufSize   = 2 * spookyBlockSize // size of buffer of unhashed data, in bytes
+	spookyConst     = 0xdeadbeefdeadbeef  // a constant which is not zero, is odd, and has a not-too-regular mix of 1s and 0s
+)
+
+type spooky struct {
+	seed1, seed2 uint64
+	state        [spookyNumVars]uint64 // our hash state
+	length       int                   // current bytes written so far
+	t            [spookyBufSize]byte   // as-yet-unprocessed bytes
+	rem          int                   // how many bytes in t[] are valid
+}
+
+// NewSpookyHash returns a new Hash128 object computing SpookyHash V2 with the given seeds.
+// Sum64 returns the first 64 bits of the hash, which is SpookyHash64 when seed1 == seed2.
+func NewSpookyHash(seed1, seed2 uint64) Hash128 {
+	s := new(spooky)
+	s.seed1 = seed1
+	s.seed2 = seed2
+	s.Reset()
+	return s
+}
+
+// SpookyHash128 returns the 128-bit SpookyHash V2 of message with the given seeds
+func SpookyHash128(message []byte, seed1, seed2 uint64) (uint64, uint64) {
+
+	length := len(message)
+
+	if length < spookyBufSize {
+		return spookyShort(message, seed1, seed2)
+	}
+
+	var h [spookyNumVars]uint64
+
+	for i := 0; i < spookyNumVars; i += 3 {
+		h[i] = seed1
+		h[i+1] = seed2
+		h[i+2] = spookyConst
+	}
+
+	// handle all whole blockSize blocks of bytes
+	b := length - length%spookyBlockSize
+	for i := 0; i < b; i += spookyBlockSize {
+		spookyMix(message[i:], &h)
+	}
+
+	// handle the last partial block of blockSize bytes
+	var buf [spookyBlockSize]byte
+	rem := copy(buf[:], message[b:])
+	buf[spookyBlockSize-1] = byte(rem)
+
+	// do some final mixing
+	spookyEnd(buf[:], &h)
+
+	return h[0], h[1]
+}
+
+// SpookyHash64 returns the 64-bit SpookyHash V2 of message with the given seed
+func SpookyHash64(message []byte, seed uint64) uint64 {
+	h1, _ := SpookyHash128(message, seed, seed)
+	return h1
+}
+
+// SpookyHash32 returns the 32-bit SpookyHash V2 of message with the given seed
+func SpookyHash32(message []byte, seed uint32) uint32 {
+	h1, _ := SpookyHash128(message, uint64(seed), uint64(seed))
+	return uint32(h1)
+}
+
+func (s *spooky) Size() int      { return 16 }
+func (s *spooky) BlockSize() int { return spookyBlockSize }
+func (s *spooky) Reset() {
+	s.state[0] = s.seed1
+	s.state[1] = s.seed2
+	s.length = 0
+	s.rem = 0
+}
+
+// the Update() method from the reference code
+func (s *spooky) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+
+	newLength := datalen + s.rem
+
+	// Is this message fragment too short?  If it is, stuff it away.
+	if newLength < spookyBufSize {
+		copy(s.t[s.rem:], data)
+		s.length += datalen
+		s.rem = newLength
+		return datalen, nil
+	}
+
+	// init the variables
+	if s.length < spookyBufSize {
+		seed1, seed2 := s.state[0], s.state[1]
+		for i := 0; i < spookyNumVars; i += 3 {
+			s.state[i] = seed1
+			s.state[i+1] = seed2
+			s.state[i+2] = spookyConst
+		}
+	}
+
+	s.length += datalen
+
+	// if we've got anything stuffed away, use it now
+	if s.rem != 0 {
+		n := copy(s.t[s.rem:], data)
+		spookyMix(s.t[:], &s.state)
+		spookyMix(s.t[spookyBlockSize:], &s.state)
+		data = data[n:]
+	}
+
+	// handle all whole blocks of blockSize bytes
+	length := len(data)
+	rem := length % spookyBlockSize
+	b := length - rem
+
+	for i := 0; i < b; i += spookyBlockSize {
+		spookyMix(data[i:], &s.state)
+	}
+
+	// stuff away the last few bytes
+	copy(s.t[:rem], data[b:])
+
+	s.rem = rem
+
+	return datalen, nil
+}
+
+func (s *spooky) Sum(b []byte) []byte {
+	h1, h2 := s.Sum128()
+	return appendSum128(b, h1, h2)
+}
+
+// Sum64 returns the first 64 bits of the 128-bit hash
+func (s *spooky) Sum64() uint64 {
+	h1, _ := s.Sum128()
+	return h1
+}
+
+// the Final() method from the reference code
+func (s *spooky) Sum128() (uint64, uint64) {
+
+	if s.length < spookyBufSize {
+		return spookyShort(s.t[:s.length], s.state[0], s.state[1])
+	}
+
+	// copy so as not to change the internal state
+	h := s.state
+
+	data := s.t[:]
+	rem := s.rem
+
+	// t can contain two blocks; handle any whole first block
+	if rem >= spookyBlockSize {
+		spookyMix(data, &h)
+		data = data[spookyBlockSize:]
+		rem -= spookyBlockSize
+	}
+
+	// mix in the last partial block, and the length mod blockSize
+	var buf [spookyBlockSize]byte
+	copy(buf[:], data[:rem])
+	buf[spookyBlockSize-1] = byte(rem)
+
+	spookyEnd(buf[:], &h)
+
+	return h[0], h[1]
+}
+
+// This is used if the input is 96 bytes long or longer.
+//
+// The internal state is fully overwritten every 96 bytes.
+// Every input bit appears to cause at least 128 bits of entropy
+// before 96 other bytes are combined, when run forward or backward.
+func spookyMix(data []byte, h *[spookyNumVars]uint64) {
+
+	s0, s1, s2, s3, s4, s5, s6, s7, s8, s9, s10, s11 := h[0], h[1], h[2], h[3], h[4], h[5], h[6], h[7], h[8], h[9], h[10], h[11]
+
+	s0 += readLE64(data[0:])
+	s2 ^= s10
+	s11 ^= s0
+	s0 = rotl64(s0, 11)
+	s11 += s1
+	s1 += readLE64(data[8:])
+	s3 ^= s11
+	s0 ^= s1
+	s1 = rotl64(s1, 32)
+	s0 += s2
+	s2 += readLE64(data[16:])
+	s4 ^= s0
+	s1 ^= s2
+	s2 = rotl64(s2, 43)
+	s1 += s3
+	s3 += readLE64(data[24:])
+	s5 ^= s1
+	s2 ^= s3
+	s3 = rotl64(s3, 31)
+	s2 += s4
+	s4 += readLE64(data[32:])
+	s6 ^= s2
+	s3 ^= s4
+	s4 = rotl64(s4, 17)
+	s3 += s5
+	s5 += readLE64(data[40:])
+	s7 ^= s3
+	s4 ^= s5
+	s5 = rotl64(s5, 28)
+	s4 += s6
+	s6 += readLE64(data[48:])
+	s8 ^= s4
+	s5 ^= s6
+	s6 = rotl64(s6, 39)
+	s5 += s7
+	s7 += readLE64(data[56:])
+	s9 ^= s5
+	s6 ^= s7
+	s7 = rotl64(s7, 57)
+	s6 += s8
+	s8 += readLE64(data[64:])
+	s10 ^= s6
+	s7 ^= s8
+	s8 = rotl64(s8, 55)
+	s7 += s9
+	s9 += readLE64(data[72:])
+	s11 ^= s7
+	s8 ^= s9
+	s9 = rotl64(s9, 54)
+	s8 += s10
+	s10 += readLE64(data[80:])
+	s0 ^= s8
+	s9 ^= s10
+	s10 = rotl64(s10, 22)
+	s9 += s11
+	s11 += readLE64(data[88:])
+	s1 ^= s9
+	s10 ^= s11
+	s11 = rotl64(s11, 46)
+	s10 += s0
+
+	h[0], h[1], h[2], h[3], h[4], h[5], h[6], h[7], h[8], h[9], h[10], h[11] = s0, s1, s2, s3, s4, s5, s6, s7, s8, s9, s10, s11
+}
+
+// Mix all 12 inputs together so that h0, h1 are a hash of them all.
+func spookyEndPartial(h *[spookyNumVars]uint64) {
+
+	h0, h1, h2, h3, h4, h5, h6, h7, h8, h9, h10, h11 := h[0], h[1], h[2], h[3], h[4], h[5], h[6], h[7], h[8], h[9], h[10], h[11]
+
+	h11 += h1
+	h2 ^= h11
+	h1 = rotl64(h1, 44)
+	h0 += h2
+	h3 ^= h0
+	h2 = rotl64(h2, 15)
+	h1 += h3
+	h4 ^= h1
+	h3 = rotl64(h3, 34)
+	h2 += h4
+	h5 ^= h2
+	h4 = rotl64(h4, 21)
+	h3 += h5
+	h6 ^= h3
+	h5 = rotl64(h5, 38)
+	h4 += h6
+	h7 ^= h4
+	h6 = rotl64(h6, 33)
+	h5 += h7
+	h8 ^= h5
+	h7 = rotl64(h7, 10)
+	h6 += h8
+	h9 ^= h6
+	h8 = rotl64(h8, 13)
+	h7 += h9
+	h10 ^= h7
+	h9 = rotl64(h9, 38)
+	h8 += h10
+	h11 ^= h8
+	h10 = rotl64(h10, 53)
+	h9 += h11
+	h0 ^= h9
+	h11 = rotl64(h11, 42)
+	h10 += h0
+	h1 ^= h10
+	h0 = rotl64(h0, 54)
+
+	h[0], h[1], h[2], h[3], h[4], h[5], h[6], h[7], h[8], h[9], h[10], h[11] = h0, h1, h2, h3, h4, h5, h6, h7, h8, h9, h10, h11
+}
+
+func spookyEnd(data []byte, h *[spookyNumVars]uint64) {
+	for i := range h {
+		h[i] += readLE64(data[i*8:])
+	}
+	spookyEndPartial(h)
+	spookyEndPartial(h)
+	spookyEndPartial(h)
+}
+
+// The goal is for each bit of the input to expand into 128 bits of
+// apparent entropy before it is fully overwritten.
+func spookyShortMix(h0, h1, h2, h3 uint64) (uint64, uint64, uint64, uint64) {
+	h2 = rotl64(h2, 50)
+	h2 += h3
+	h0 ^= h2
+	h3 = rotl64(h3, 52)
+	h3 += h0
+	h1 ^= h3
+	h0 = rotl64(h0, 30)
+	h0 += h1
+	h2 ^= h0
+	h1 = rotl64(h1, 41)
+	h1 += h2
+	h3 ^= h1
+	h2 = rotl64(h2, 54)
+	h2 += h3
+	h0 ^= h2
+	h3 = rotl64(h3, 48)
+	h3 += h0
+	h1 ^= h3
+	h0 = rotl64(h0, 38)
+	h0 += h1
+	h2 ^= h0
+	h1 = rotl64(h1, 37)
+	h1 += h2
+	h3 ^= h1
+	h2 = rotl64(h2, 62)
+	h2 += h3
+	h0 ^= h2
+	h3 = rotl64(h3, 34)
+	h3 += h0
+	h1 ^= h3
+	h0 = rotl64(h0, 5)
+	h0 += h1
+	h2 ^= h0
+	h1 = rotl64(h1, 36)
+	h1 += h2
+	h3 ^= h1
+	return h0, h1, h2, h3
+}
+
+// Mix all 4 inputs together so that h0, h1 are a hash of them all.
+func spookyShortEnd(h0, h1, h2, h3 uint64) (uint64, uint64, uint64, uint64) {
+	h3 ^= h2
+	h2 = rotl64(h2, 15)
+	h3 += h2
+	h0 ^= h3
+	h3 = rotl64(h3, 52)
+	h0 += h3
+	h1 ^= h0
+	h0 = rotl64(h0, 26)
+	h1 += h0
+	h2 ^= h1
+	h1 = rotl64(h1, 51)
+	h2 += h1
+	h3 ^= h2
+	h2 = rotl64(h2, 28)
+	h3 += h2
+	h0 ^= h3
+	h3 = rotl64(h3, 9)
+	h0 += h3
+	h1 ^= h0
+	h0 = rotl64(h0, 47)
+	h1 += h0
+	h2 ^= h1
+	h1 = rotl64(h1, 54)
+	h2 += h1
+	h3 ^= h2
+	h2 = rotl64(h2, 32)
+	h3 += h2
+	h0 ^= h3
+	h3 = rotl64(h3, 25)
+	h0 += h3
+	h1 ^= h0
+	h0 = rotl64(h0, 63)
+	h1 += h0
+	return h0, h1, h2, h3
+}
+
+// short hash ... it could be used on any message,
+// but it's used by Spooky just for short messages.
+func spookyShort(message []byte, seed1, seed2 uint64) (uint64, uint64) {
+
+	length := len(message)
+
+	rem := length % 32
+	a := seed1
+	b := seed2
+	c := uint64(spookyConst)
+	d := uint64(spookyConst)
+
+	if length > 15 {
+
+		// handle all complete sets of 32 bytes
+		for len(message) >= 32 {
+			c += readLE64(message)
+			d += readLE64(message[8:])
+			a, b, c, d = spookyShortMix(a, b, c, d)
+			a += readLE64(message[16:])
+			b += readLE64(message[24:])
+			message = message[32:]
+		}
+
+		// Handle the case of 16+ remaining bytes.
+		if rem >= 16 {
+			c += readLE64(message)
+			d += readLE64(message[8:])
+			a, b, c, d = spookyShortMix(a, b, c, d)
+			message = message[16:]
+			rem -= 16
+		}
+	}
+
+	// Handle the last 0..15 bytes, and its length
+	d += uint64(length) << 56
+
+	switch rem {
+	case 15:
+		d += uint64(message[14]) << 48
+		fallthrough
+	case 14:
+		d += uint64(message[13]) << 40
+		fallthrough
+	case 13:
+		d += uint64(message[12]) << 32
+		fallthrough
+	case 12:
+		d += uint64(readLE32(message[8:]))
+		c += readLE64(message)
+	case 11:
+		d += uint64(message[10]) << 16
+		fallthrough
+	case 10:
+		d += uint64(message[9]) << 8
+		fallthrough
+	case 9:
+		d += uint64(message[8])
+		fallthrough
+	case 8:
+		c += readLE64(message)
+	case 7:
+		c += uint64(message[6]) << 48
+		fallthrough
+	case 6:
+		c += uint64(message[5]) << 40
+		fallthrough
+	case 5:
+		c += uint64(message[4]) << 32
+		fallthrough
+	case 4:
+		c += uint64(readLE32(message))
+	case 3:
+		c += uint64(message[2]) << 16
+		fallthrough
+	case 2:
+		c += uint64(message[1]) << 8
+		fallthrough
+	case 1:
+		c += uint64(message[0])
+	case 0:
+		c += spookyConst
+		d += spookyConst
+	}
+
+	a, b, _, _ = spookyShortEnd(a, b, c, d)
+
+	return a, b
+}
@@ -1,5 +1,5 @@
 // Package dgohash implements a number of well-known string hashing functions.
-// They all conform to the hash.Hash32 interface.
+// They all conform to the hash.Hash32 or hash.Hash64 interface.
 // Copyright (c) 2011 Damian Gryski <damian@gryski.com>
 // Licensed under the GPLv3, or at your option any later version.
 package dgohash
@@ -30,6 +30,28 @@ func (sh *javaStringHash32) Write(b []byte) (int, error) {
 	return len(b), nil
 }
 
+type javaStringHash64 uint64
+
+// NewJava64 returns a new hash.Hash64 object, computing the 64-bit version of Java's string.hashCode(), as accumulated in a long
+func NewJava64() hash.Hash64                { sh := javaStringHash64(0); sh.Reset(); return &sh }
+func (sh *javaStringHash64) Size() int      { return 8 }
+func (sh *javaStringHash64) BlockSize() int { return 1 }
+func (sh *javaStringHash64) Sum64() uint64  { return uint64(*sh) }
+func (sh *javaStringHash64) Reset()         { *sh = javaStringHash64(0) }
+func (sh *javaStringHash64) Sum(b []byte) []byte {
+	v := uint64(*sh)
+	return append(b, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *javaStringHash64) Write(b []byte) (int, error) {
+	h := uint64(*sh)
+	for _, c := range b {
+		h = 31*h + uint64(c)
+	}
+	*sh = javaStringHash64(h)
+	return len(b), nil
+}
+
 type djb2StringHash32 uint32
 
 // NewDjb32 returns a new hash.Hash32 object, computing Daniel J. Bernstein's hash
@@ -52,6 +74,28 @@ func (sh *djb2StringHash32) Write(b []byte) (int, error) {
 	return len(b), nil
 }
 
+type djb2StringHash64 uint64
+
+// NewDjb64 returns a new hash.Hash64 object, computing the 64-bit version of Daniel J. Bernstein's hash
+func NewDjb64() hash.Hash64                 { sh := djb2StringHash64(0); sh.Reset(); return &sh }
+func (sh *djb2StringHash64) Size() int      { return 8 }
+func (sh *djb2StringHash64) BlockSize() int { return 1 }
+func (sh *djb2StringHash64) Sum64() uint64  { return uint64(*sh) }
+func (sh *djb2StringHash64) Reset()         { *sh = djb2StringHash64(5381) }
+func (sh *djb2StringHash64) Sum(b []byte) []byte {
+	v := uint64(*sh)
+	return append(b, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *djb2StringHash64) Write(b []byte) (int, error) {
+	h := uint64(*sh)
+	for _, c := range b {
+		h = 33*h + uint64(c)
+	}
+	*sh = djb2StringHash64(h)
+	return len(b), nil
+}
+
 type djb2aStringHash32 uint32
 
 // NewDjb32a returns a new hash.Hash32 object, computing a variant of Daniel J. Bernstein's hash that uses xor instead of +
@@ -74,6 +118,28 @@ func (sh *djb2aStringHash32) Write(b []byte) (int, error) {
 	return len(b), nil
 }
 
+type djb2aStringHash64 uint64
+
+// NewDjb64a returns a new hash.Hash64 object, computing the 64-bit version of the xor variant of Daniel J. Bernstein's hash
+func NewDjb64a() hash.Hash64                 { sh := djb2aStringHash64(0); sh.Reset(); return &sh }
+func (sh *djb2aStringHash64) Size() int      { return 8 }
+func (sh *djb2aStringHash64) BlockSize() int { return 1 }
+func (sh *djb2aStringHash64) Sum64() uint64  { return uint64(*sh) }
+func (sh *djb2aStringHash64) Reset()         { *sh = djb2aStringHash64(5381) }
+func (sh *djb2aStringHash64) Sum(b []byte) []byte {
+	v := uint64(*sh)
+	return append(b, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *djb2aStringHash64) Write(b []byte) (int, error) {
+	h := uint64(*sh)
+	for _, c := range b {
+		h = 33*h ^ uint64(c)
+	}
+	*sh = djb2aStringHash64(h)
+	return len(b), nil
+}
+
 type elf32StringHash32 uint32
 
 // NewElf32 returns a new hash.Hash32 object computing the ELF32 symbol hash
@@ -123,6 +189,28 @@ func (sh *sdbmStringHash32) Write(b []byte) (int, error) {
 	return len(b), nil
 }
 
+type sdbmStringHash64 uint64
+
+// NewSDBM64 returns a new hash.Hash64 object, computing the 64-bit version of the string hash function from SDBM
+func NewSDBM64() hash.Hash64                { sh := sdbmStringHash64(0); sh.Reset(); return &sh }
+func (sh *sdbmStringHash64) Size() int      { return 8 }
+func (sh *sdbmStringHash64) BlockSize() int { return 1 }
+func (sh *sdbmStringHash64) Sum64() uint64  { return uint64(*sh) }
+func (sh *sdbmStringHash64) Reset()         { *sh = sdbmStringHash64(0) }
+func (sh *sdbmStringHash64) Sum(b []byte) []byte {
+	v := uint64(*sh)
+	return append(b, byte(v>>56), byte(v>>48), byte(v>>40), byte(v>>32), byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *sdbmStringHash64) Write(b []byte) (int, error) {
+	h := uint64(*sh)
+	for _, c := range b {
+		h = uint64(c) + (h << 6) + (h << 16) - h
+	}
+	*sh = sdbmStringHash64(h)
+	return len(b), nil
+}
+
 type sqlite3StringHash32 uint32
 
 // NewSQLite32 returns a new hash.Hash32 object, computing the string hash function from SQLite3
@@ -182,3 +270,242 @@ func (sh *jenkinsStringHash32) Sum(b []byte) []byte {
 	v := sh.Sum32()
 	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
 }
+
+// The following are the general purpose hash functions collected by Arash
+// Partow at http://www.partow.net/programming/hashfunctions/, plus the two
+// hashes from Kernighan and Ritchie's "The C Programming Language".  The
+// reference code reads plain chars; here, as with the hashes above, input
+// bytes are treated as unsigned.
+
+type rsStringHash32 struct {
+	h, a uint32
+}
+
+// NewRS32 returns a new hash.Hash32 object, computing Robert Sedgwick's hash from "Algorithms in C"
+func NewRS32() hash.Hash32                { sh := new(rsStringHash32); sh.Reset(); return sh }
+func (sh *rsStringHash32) Size() int      { return 4 }
+func (sh *rsStringHash32) BlockSize() int { return 1 }
+func (sh *rsStringHash32) Sum32() uint32  { return sh.h }
+func (sh *rsStringHash32) Reset()         { sh.h = 0; sh.a = 63689 }
+func (sh *rsStringHash32) Sum(b []byte) []byte {
+	v := sh.h
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *rsStringHash32) Write(b []byte) (int, error) {
+	h, a := sh.h, sh.a
+	for _, c := range b {
+		h = h*a + uint32(c)
+		a *= 378551
+	}
+	sh.h, sh.a = h, a
+	return len(b), nil
+}
+
+type jsStringHash32 uint32
+
+// NewJS32 returns a new hash.Hash32 object, computing Justin Sobel's bitwise hash
+func NewJS32() hash.Hash32                { sh := jsStringHash32(0); sh.Reset(); return &sh }
+func (sh *jsStringHash32) Size() int      { return 4 }
+func (sh *jsStringHash32) BlockSize() int { return 1 }
+func (sh *jsStringHash32) Sum32() uint32  { return uint32(*sh) }
+func (sh *jsStringHash32) Reset()         { *sh = jsStringHash32(1315423911) }
+func (sh *jsStringHash32) Sum(b []byte) []byte {
+	v := uint32(*sh)
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *jsStringHash32) Write(b []byte) (int, error) {
+	h := uint32(*sh)
+	for _, c := range b {
+		h ^= (h << 5) + uint32(c) + (h >> 2)
+	}
+	*sh = jsStringHash32(h)
+	return len(b), nil
+}
+
+type pjwStringHash32 uint32
+
+// NewPJW32 returns a new hash.Hash32 object, computing Peter J. Weinberger's hash from the Dragon Book.
+// For 32-bit ints this gives the same result as the ELF32 hash, which is derived from it.
+func NewPJW32() hash.Hash32                { sh := pjwStringHash32(0); sh.Reset(); return &sh }
+func (sh *pjwStringHash32) Size() int      { return 4 }
+func (sh *pjwStringHash32) BlockSize() int { return 1 }
+func (sh *pjwStringHash32) Sum32() uint32  { return uint32(*sh) }
+func (sh *pjwStringHash32) Reset()         { *sh = pjwStringHash32(0) }
+func (sh *pjwStringHash32) Sum(b []byte) []byte {
+	v := uint32(*sh)
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *pjwStringHash32) Write(b []byte) (int, error) {
+	const (
+		bitsInUnsignedInt = 32
+		threeQuarters     = (bitsInUnsignedInt * 3) / 4
+		oneEighth         = bitsInUnsignedInt / 8
+		highBits          = 0xffffffff << (bitsInUnsignedInt - oneEighth) & 0xffffffff
+	)
+	h := uint32(*sh)
+	for _, c := range b {
+		h = (h << oneEighth) + uint32(c)
+		if test := h & highBits; test != 0 {
+			h = (h ^ (test >> threeQuarters)) &^ highBits
+		}
+	}
+	*sh = pjwStringHash32(h)
+	return len(b), nil
+}
+
+type bkdrStringHash32 uint32
+
+// NewBKDR32 returns a new hash.Hash32 object, computing the hash from Brian Kernighan and Dennis Ritchie's "The C Programming Language" with a seed of 131
+func NewBKDR32() hash.Hash32                { sh := bkdrStringHash32(0); sh.Reset(); return &sh }
+func (sh *bkdrStringHash32) Size() int      { return 4 }
+func (sh *bkdrStringHash32) BlockSize() int { return 1 }
+func (sh *bkdrStringHash32) Sum32() uint32  { return uint32(*sh) }
+func (sh *bkdrStringHash32) Reset()         { *sh = bkdrStringHash32(0) }
+func (sh *bkdrStringHash32) Sum(b []byte) []byte {
+	v := uint32(*sh)
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *bkdrStringHash32) Write(b []byte) (int, error) {
+	h := uint32(*sh)
+	for _, c := range b {
+		h = h*131 + uint32(c)
+	}
+	*sh = bkdrStringHash32(h)
+	return len(b), nil
+}
+
+type dekStringHash32 struct {
+	h      uint32
+	length int
+}
+
+// NewDEK32 returns a new hash.Hash32 object, computing Donald E. Knuth's hash from "The Art Of Computer Programming Volume 3"
+func NewDEK32() hash.Hash32                { sh := new(dekStringHash32); sh.Reset(); return sh }
+func (sh *dekStringHash32) Size() int      { return 4 }
+func (sh *dekStringHash32) BlockSize() int { return 1 }
+func (sh *dekStringHash32) Reset()         { sh.h = 0; sh.length = 0 }
+func (sh *dekStringHash32) Sum(b []byte) []byte {
+	v := sh.Sum32()
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *dekStringHash32) Write(b []byte) (int, error) {
+	h := sh.h
+	for _, c := range b {
+		h = ((h << 5) ^ (h >> 27)) ^ uint32(c)
+	}
+	sh.h = h
+	sh.length += len(b)
+	return len(b), nil
+}
+
+// The reference code starts with the hash set to the length of the input.
+// Each step is a rotate and an xor, so we can start from zero instead and
+// xor in the length, rotated once for every byte, at the end.
+func (sh *dekStringHash32) Sum32() uint32 {
+	return sh.h ^ rotl32(uint32(sh.length), uint8(5*sh.length%32))
+}
+
+type bpStringHash32 uint32
+
+// NewBP32 returns a new hash.Hash32 object, computing the BP hash
+func NewBP32() hash.Hash32                { sh := bpStringHash32(0); sh.Reset(); return &sh }
+func (sh *bpStringHash32) Size() int      { return 4 }
+func (sh *bpStringHash32) BlockSize() int { return 1 }
+func (sh *bpStringHash32) Sum32() uint32  { return uint32(*sh) }
+func (sh *bpStringHash32) Reset()         { *sh = bpStringHash32(0) }
+func (sh *bpStringHash32) Sum(b []byte) []byte {
+	v := uint32(*sh)
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *bpStringHash32) Write(b []byte) (int, error) {
+	h := uint32(*sh)
+	for _, c := range b {
+		h = h<<7 ^ uint32(c)
+	}
+	*sh = bpStringHash32(h)
+	return len(b), nil
+}
+
+type apStringHash32 struct {
+	h      uint32
+	length int
+}
+
+// NewAP32 returns a new hash.Hash32 object, computing Arash Partow's hash
+func NewAP32() hash.Hash32                { sh := new(apStringHash32); sh.Reset(); return sh }
+func (sh *apStringHash32) Size() int      { return 4 }
+func (sh *apStringHash32) BlockSize() int { return 1 }
+func (sh *apStringHash32) Sum32() uint32  { return sh.h }
+func (sh *apStringHash32) Reset()         { sh.h = 0xaaaaaaaa; sh.length = 0 }
+func (sh *apStringHash32) Sum(b []byte) []byte {
+	v := sh.h
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *apStringHash32) Write(b []byte) (int, error) {
+	h := sh.h
+	// even and odd positions in the input are mixed in differently
+	i := sh.length
+	for _, c := range b {
+		if i&1 == 0 {
+			h ^= (h << 7) ^ uint32(c)*(h>>3)
+		} else {
+			h ^= ^((h << 11) + (uint32(c) ^ (h >> 5)))
+		}
+		i++
+	}
+	sh.h = h
+	sh.length = i
+	return len(b), nil
+}
+
+type kr1StringHash32 uint32
+
+// NewKR1_32 returns a new hash.Hash32 object, computing the hash from the first edition of "The C Programming Language", which simply adds up the bytes
+func NewKR1_32() hash.Hash32               { sh := kr1StringHash32(0); sh.Reset(); return &sh }
+func (sh *kr1StringHash32) Size() int      { return 4 }
+func (sh *kr1StringHash32) BlockSize() int { return 1 }
+func (sh *kr1StringHash32) Sum32() uint32  { return uint32(*sh) }
+func (sh *kr1StringHash32) Reset()         { *sh = kr1StringHash32(0) }
+func (sh *kr1StringHash32) Sum(b []byte) []byte {
+	v := uint32(*sh)
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *kr1StringHash32) Write(b []byte) (int, error) {
+	h := uint32(*sh)
+	for _, c := range b {
+		h += uint32(c)
+	}
+	*sh = kr1StringHash32(h)
+	return len(b), nil
+}
+
+type kr2StringHash32 uint32
+
+// NewKR2_32 returns a new hash.Hash32 object, computing the hash from the second edition of "The C Programming Language".
+// The book reduces the result modulo the table size; that is left to the caller.
+func NewKR2_32() hash.Hash32               { sh := kr2StringHash32(0); sh.Reset(); return &sh }
+func (sh *kr2StringHash32) Size() int      { return 4 }
+func (sh *kr2StringHash32) BlockSize() int { return 1 }
+func (sh *kr2StringHash32) Sum32() uint32  { return uint32(*sh) }
+func (sh *kr2StringHash32) Reset()         { *sh = kr2StringHash32(0) }
+func (sh *kr2StringHash32) Sum(b []byte) []byte {
+	v := uint32(*sh)
+	return append(b, byte(v>>24), byte(v>>16), byte(v>>8), byte(v))
+}
+
+func (sh *kr2StringHash32) Write(b []byte) (int, error) {
+	h := uint32(*sh)
+	for _, c := range b {
+		h = uint32(c) + 31*h
+	}
+	*sh = kr2StringHash32(h)
+	return len(b), nil
+}
@@ -16,20 +16,40 @@ import (
 )
 
 type superfast struct {
-	h1  uint32  // our hash state
-	t   [4]byte // as-yet-unprocessed bytes
-	rem int     // how many bytes in t[] are valid
+	seed   uint32  // initial hash state
+	exact  bool    // match the reference code exactly
+	h1     uint32  // our hash state
+	length int     // total bytes written so far
+	t      [4]byte // as-yet-unprocessed bytes
+	rem    int     // how many bytes in t[] are valid
 }
 
 func (m *superfast) Size() int      { return 4 }
 func (m *superfast) BlockSize() int { return 4 }
-func (m *superfast) Reset()         { m.h1 = uint32(0); m.rem = 0 }
+func (m *superfast) Reset()         { m.h1 = m.seed; m.length = 0; m.rem = 0 }
 
 // NewSuperFastHash returns a new hash.Hash32 object computing the incremental SuperFastHash
 func NewSuperFastHash() hash.Hash32 {
 	return new(superfast)
 }
 
+// NewSuperFastHashLength returns a new hash.Hash32 object computing the reference SuperFastHash.
+// The reference code starts with the hash state set to the length of the input, so the total number of bytes
+// that will be written must be known in advance.  If a different number of bytes is written the result is meaningless.
+func NewSuperFastHashLength(length int) hash.Hash32 {
+	m := &superfast{seed: uint32(length), exact: true}
+	m.Reset()
+	return m
+}
+
+// SuperFastHash returns the SuperFastHash of data, exactly as computed by the reference C code
+func SuperFastHash(data []byte) uint32 {
+	m := superfast{seed: uint32(len(data)), exact: true}
+	m.Reset()
+	m.Write(data)
+	return m.Sum32()
+}
+
 // computes new hash state h1 merged with bytes in k1,k2
 func (m *superfast) update(k1, k2 uint32) {
 	m.h1 += k1
@@ -45,6 +65,8 @@ func (m *superfast) Write(data []byte) (int, error) {
 
 	length := datalen
 
+	m.length += datalen
+
 	// Since the hash actually processes uint32s, but we allow []byte to be
 	// Written, we have to keep track of the tail bytes that haven't yet
 	// been processed, and do that on next round if we can scrounge
@@ -120,14 +142,27 @@ func (m *superfast) Sum(b []byte) []byte {
 // superfast finalize step
 func (m *superfast) Sum32() uint32 {
 
+	// the reference code returns 0 for empty input
+	if m.exact && m.length == 0 {
+		return 0
+	}
+
 	// copy so as not to change the internal state
 	h1 := m.h1
 
+	// the reference code reads the odd tail bytes as signed chars
+	tail := func(b byte) uint32 {
+		if m.exact {
+			return uint32(int8(b))
+		}
+		return uint32(b)
+	}
+
 	switch m.rem {
 	case 3:
 		h1 += uint32(m.t[0]) | uint32(m.t[1])<<8
 		h1 ^= h1 << 16
-		h1 ^= uint32(m.t[2]) << 18
+		h1 ^= tail(m.t[2]) << 18
 		h1 += h1 >> 11
 		break
 	case 2:
@@ -136,7 +171,7 @@ func (m *superfast) Sum32() uint32 {
 		h1 += h1 >> 17
 		break
 	case 1:
-		h1 += uint32(m.t[0])
+		h1 += tail(m.t[0])
 		h1 ^= h1 << 10
 		h1 += h1 >> 1
 		break
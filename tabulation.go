@@ -0,0 +1,154 @@
+// This file is an implementation of simple and twisted tabulation hashing by Mihai Pătraşcu and Mikkel Thorup
+// The algorithms are described in "Twisted Tabulation Hashing", SODA 2013, and Thorup's "Fast and Powerful Hashing using Tabulation", CACM 60(7), 2017
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// The keys are split into bytes, each of which indexes its own table of
+// random values.  Simple tabulation XORs the values together; it is
+// 3-independent and behaves far better in practice than that suggests.
+// Twisted tabulation also draws a random byte from each table but the last,
+// and XORs them into the last byte of the key before it is looked up, which
+// gives stronger concentration bounds.  The tables are filled from the seed
+// with splitmix64, and byte strings are pre-hashed as described in
+// universal.go.
+
+package dgohash
+
+// Tabulation32 is a simple tabulation hash function for 32-bit keys
+type Tabulation32 struct {
+	polyPrehash
+	t [4][256]uint32
+}
+
+// NewTabulation32 returns the simple tabulation hash function for 32-bit keys chosen by seed
+func NewTabulation32(seed uint64) *Tabulation32 {
+	t := &Tabulation32{polyPrehash: newPolyPrehash(&seed)}
+	for i := range t.t {
+		for j := range t.t[i] {
+			t.t[i][j] = uint32(splitmix64(&seed))
+		}
+	}
+	return t
+}
+
+// Hash returns the hash of x
+func (t *Tabulation32) Hash(x uint32) uint32 {
+	var h uint32
+	for i := range t.t {
+		h ^= t.t[i][byte(x)]
+		x >>= 8
+	}
+	return h
+}
+
+// HashBytes returns the hash of data
+func (t *Tabulation32) HashBytes(data []byte) uint32 {
+	return t.Hash(uint32(t.prehash(data)))
+}
+
+// Tabulation64 is a simple tabulation hash function for 64-bit keys
+type Tabulation64 struct {
+	polyPrehash
+	t [8][256]uint64
+}
+
+// NewTabulation64 returns the simple tabulation hash function for 64-bit keys chosen by seed
+func NewTabulation64(seed uint64) *Tabulation64 {
+	t := &Tabulation64{polyPrehash: newPolyPrehash(&seed)}
+	for i := range t.t {
+		for j := range t.t[i] {
+			t.t[i][j] = splitmix64(&seed)
+		}
+	}
+	return t
+}
+
+// Hash returns the hash of x
+func (t *Tabulation64) Hash(x uint64) uint64 {
+	var h uint64
+	for i := range t.t {
+		h ^= t.t[i][byte(x)]
+		x >>= 8
+	}
+	return h
+}
+
+// HashBytes returns the hash of data
+func (t *Tabulation64) HashBytes(data []byte) uint64 {
+	return t.Hash(t.prehash(data))
+}
+
+// TwistedTabulation32 is a twisted tabulation hash function for 32-bit keys.
+// As in Thorup's code, each table entry holds the twister in its low byte and the hash in its high 32 bits.
+type TwistedTabulation32 struct {
+	polyPrehash
+	t [4][256]uint64
+}
+
+// NewTwistedTabulation32 returns the twisted tabulation hash function for 32-bit keys chosen by seed
+func NewTwistedTabulation32(seed uint64) *TwistedTabulation32 {
+	t := &TwistedTabulation32{polyPrehash: newPolyPrehash(&seed)}
+	for i := range t.t {
+		for j := range t.t[i] {
+			t.t[i][j] = splitmix64(&seed)
+		}
+	}
+	return t
+}
+
+// Hash returns the hash of x
+func (t *TwistedTabulation32) Hash(x uint32) uint32 {
+	var h uint64
+	for i := 0; i < 3; i++ {
+		h ^= t.t[i][byte(x)]
+		x >>= 8
+	}
+	// the last byte of the key, twisted
+	h ^= t.t[3][byte(x)^byte(h)]
+	return uint32(h >> 32)
+}
+
+// HashBytes returns the hash of data
+func (t *TwistedTabulation32) HashBytes(data []byte) uint32 {
+	return t.Hash(uint32(t.prehash(data)))
+}
+
+// TwistedTabulation64 is a twisted tabulation hash function for 64-bit keys
+type TwistedTabulation64 struct {
+	polyPrehash
+	t  [8][256]uint64
+	tw [7][256]byte // the twisters
+}
+
+// NewTwistedTabulation64 returns the twisted tabulation hash function for 64-bit keys chosen by seed
+func NewTwistedTabulation64(seed uint64) *TwistedTabulation64 {
+	t := &TwistedTabulation64{polyPrehash: newPolyPrehash(&seed)}
+	for i := range t.t {
+		for j := range t.t[i] {
+			t.t[i][j] = splitmix64(&seed)
+		}
+	}
+	for i := range t.tw {
+		for j := range t.tw[i] {
+			t.tw[i][j] = byte(splitmix64(&seed))
+		}
+	}
+	return t
+}
+
+// Hash returns the hash of x
+func (t *TwistedTabulation64) Hash(x uint64) uint64 {
+	var h uint64
+	var tw byte
+	for i := 0; i < 7; i++ {
+		h ^= t.t[i][byte(x)]
+		tw ^= t.tw[i][byte(x)]
+		x >>= 8
+	}
+	return h ^ t.t[7][byte(x)^tw]
+}
+
+// HashBytes returns the hash of data
+func (t *TwistedTabulation64) HashBytes(data []byte) uint64 {
+	return t.Hash(t.prehash(data))
+}
@@ -0,0 +1,199 @@
+// This file is an implementation of the multiply-shift and multiply-add-shift hash families by Martin Dietzfelbinger
+// The algorithms are described in "Universal hashing and k-wise independent random variables via integer arithmetic without primes", STACS 1996
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// Unlike the rest of the package these are families of hash functions: the
+// seed picks a member of the family at random, and the guarantees hold over
+// that choice.  The seed is expanded with splitmix64, so the guarantees are
+// only as good as splitmix64 is random.
+//
+// Byte strings are first hashed to a value below 2^61-1 by evaluating them,
+// 4 bytes at a time and followed by their length, as a polynomial at a
+// random point.  Two different strings of at most n bytes collide with
+// probability at most (n/4+2)/2^61.  The 32-bit families use the low 32 bits
+// of this value as their key.
+
+package dgohash
+
+import (
+	"math/bits"
+)
+
+// splitmix64 advances the splitmix64 generator in *state and returns its next output
+func splitmix64(state *uint64) uint64 {
+	z := Splitmix64(*state)
+	*state += 0x9e3779b97f4a7c15
+	return z
+}
+
+// the Mersenne prime 2^61-1
+const mersenne61 = 1<<61 - 1
+
+// mulmod61 returns a*b mod 2^61-1, for a, b < 2^61-1
+func mulmod61(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	// 2^64 = 8 mod 2^61-1, and hi < 2^58
+	return addmod61(lo&mersenne61, (lo>>61)+(hi<<3))
+}
+
+// addmod61 returns a+b mod 2^61-1, for a, b < 2^62
+func addmod61(a, b uint64) uint64 {
+	r := a + b
+	r = (r & mersenne61) + (r >> 61)
+	if r >= mersenne61 {
+		r -= mersenne61
+	}
+	return r
+}
+
+// polyPrehash hashes byte strings to keys for the integer hash families
+type polyPrehash struct {
+	base uint64 // the evaluation point, in [1, 2^61-1)
+}
+
+func newPolyPrehash(state *uint64) polyPrehash {
+	return polyPrehash{base: splitmix64(state)%(mersenne61-1) + 1}
+}
+
+func (p polyPrehash) prehash(data []byte) uint64 {
+
+	var h uint64
+
+	length := len(data)
+
+	for len(data) >= 4 {
+		h = addmod61(mulmod61(h, p.base), uint64(readLE32(data)))
+		data = data[4:]
+	}
+
+	if len(data) > 0 {
+		var tail [4]byte
+		copy(tail[:], data)
+		h = addmod61(mulmod61(h, p.base), uint64(readLE32(tail[:])))
+	}
+
+	// the length distinguishes strings that differ only in trailing zeros
+	return addmod61(mulmod61(h, p.base), uint64(length)%mersenne61)
+}
+
+// MultiplyShift32 is a member of the multiply-shift family of universal hash functions from 32-bit keys to l-bit values.
+// Two different keys collide with probability at most 2/2^l.
+type MultiplyShift32 struct {
+	polyPrehash
+	a uint32 // odd
+	l uint
+}
+
+// NewMultiplyShift32 returns the member of the multiply-shift family with l output bits chosen by seed.
+// It panics unless 1 <= l <= 32.
+func NewMultiplyShift32(seed uint64, l uint) *MultiplyShift32 {
+	if l < 1 || l > 32 {
+		panic("dgohash: multiply-shift output bits out of range")
+	}
+	return &MultiplyShift32{polyPrehash: newPolyPrehash(&seed), a: uint32(splitmix64(&seed)) | 1, l: l}
+}
+
+// Hash returns the hash of x
+func (m *MultiplyShift32) Hash(x uint32) uint32 {
+	return (m.a * x) >> (32 - m.l)
+}
+
+// HashBytes returns the hash of data
+func (m *MultiplyShift32) HashBytes(data []byte) uint32 {
+	return m.Hash(uint32(m.prehash(data)))
+}
+
+// MultiplyShift64 is a member of the multiply-shift family of universal hash functions from 64-bit keys to l-bit values.
+// Two different keys collide with probability at most 2/2^l.
+type MultiplyShift64 struct {
+	polyPrehash
+	a uint64 // odd
+	l uint
+}
+
+// NewMultiplyShift64 returns the member of the multiply-shift family with l output bits chosen by seed.
+// It panics unless 1 <= l <= 64.
+func NewMultiplyShift64(seed uint64, l uint) *MultiplyShift64 {
+	if l < 1 || l > 64 {
+		panic("dgohash: multiply-shift output bits out of range")
+	}
+	return &MultiplyShift64{polyPrehash: newPolyPrehash(&seed), a: splitmix64(&seed) | 1, l: l}
+}
+
+// Hash returns the hash of x
+func (m *MultiplyShift64) Hash(x uint64) uint64 {
+	return (m.a * x) >> (64 - m.l)
+}
+
+// HashBytes returns the hash of data
+func (m *MultiplyShift64) HashBytes(data []byte) uint64 {
+	return m.Hash(m.prehash(data))
+}
+
+// MultiplyAddShift32 is a member of the multiply-add-shift family of strongly universal (2-independent) hash functions from 32-bit keys to l-bit values
+type MultiplyAddShift32 struct {
+	polyPrehash
+	a, b uint64
+	l    uint
+}
+
+// NewMultiplyAddShift32 returns the member of the multiply-add-shift family with l output bits chosen by seed.
+// It panics unless 1 <= l <= 32.
+func NewMultiplyAddShift32(seed uint64, l uint) *MultiplyAddShift32 {
+	if l < 1 || l > 32 {
+		panic("dgohash: multiply-add-shift output bits out of range")
+	}
+	m := &MultiplyAddShift32{polyPrehash: newPolyPrehash(&seed), l: l}
+	m.a = splitmix64(&seed)
+	m.b = splitmix64(&seed)
+	return m
+}
+
+// Hash returns the hash of x
+func (m *MultiplyAddShift32) Hash(x uint32) uint32 {
+	return uint32((m.a*uint64(x) + m.b) >> (64 - m.l))
+}
+
+// HashBytes returns the hash of data
+func (m *MultiplyAddShift32) HashBytes(data []byte) uint32 {
+	return m.Hash(uint32(m.prehash(data)))
+}
+
+// MultiplyAddShift64 is a member of the multiply-add-shift family of strongly universal (2-independent) hash functions from 64-bit keys to l-bit values.
+// The arithmetic is done on 128-bit values.
+type MultiplyAddShift64 struct {
+	polyPrehash
+	ahi, alo uint64
+	bhi, blo uint64
+	l        uint
+}
+
+// NewMultiplyAddShift64 returns the member of the multiply-add-shift family with l output bits chosen by seed.
+// It panics unless 1 <= l <= 64.
+func NewMultiplyAddShift64(seed uint64, l uint) *MultiplyAddShift64 {
+	if l < 1 || l > 64 {
+		panic("dgohash: multiply-add-shift output bits out of range")
+	}
+	m := &MultiplyAddShift64{polyPrehash: newPolyPrehash(&seed), l: l}
+	m.ahi = splitmix64(&seed)
+	m.alo = splitmix64(&seed)
+	m.bhi = splitmix64(&seed)
+	m.blo = splitmix64(&seed)
+	return m
+}
+
+// Hash returns the hash of x
+func (m *MultiplyAddShift64) Hash(x uint64) uint64 {
+	// (a*x + b) mod 2^128, of which we only need the high word
+	hi, lo := bits.Mul64(m.alo, x)
+	hi += m.ahi * x
+	_, carry := bits.Add64(lo, m.blo, 0)
+	hi += m.bhi + carry
+	return hi >> (64 - m.l)
+}
+
+// HashBytes returns the hash of data
+func (m *MultiplyAddShift64) HashBytes(data []byte) uint64 {
+	return m.Hash(m.prehash(data))
+}
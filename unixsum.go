@@ -0,0 +1,73 @@
+// This file is an implementation of the BSD and System V checksums computed by the Unix sum command
+// The code is translated from the GPL-licensed GNU coreutils sum.c
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// Both are 16-bit checksums.  The BSD checksum rotates right before adding
+// each byte, and can't be combined.  The System V checksum is the sum of the
+// bytes folded to 16 bits with end-around carry, so the checksums of two
+// blocks can be added together.
+
+package dgohash
+
+import (
+	"hash"
+)
+
+type bsdSum uint32
+
+// NewBSDSum returns a new hash.Hash32 object computing the BSD checksum, as printed by sum -r
+func NewBSDSum() hash.Hash32      { sh := bsdSum(0); sh.Reset(); return &sh }
+func (sh *bsdSum) Size() int      { return 2 }
+func (sh *bsdSum) BlockSize() int { return 1 }
+func (sh *bsdSum) Sum32() uint32  { return uint32(*sh) }
+func (sh *bsdSum) Reset()         { *sh = bsdSum(0) }
+func (sh *bsdSum) Sum(b []byte) []byte {
+	v := uint32(*sh)
+	return append(b, byte(v>>8), byte(v))
+}
+
+func (sh *bsdSum) Write(b []byte) (int, error) {
+	h := uint32(*sh)
+	for _, c := range b {
+		h = (h >> 1) + ((h & 1) << 15)
+		h += uint32(c)
+		h &= 0xffff
+	}
+	*sh = bsdSum(h)
+	return len(b), nil
+}
+
+type sysvSum uint32
+
+// NewSysVSum returns a new hash.Hash32 object computing the System V checksum, as printed by sum -s
+func NewSysVSum() hash.Hash32      { sh := sysvSum(0); sh.Reset(); return &sh }
+func (sh *sysvSum) Size() int      { return 2 }
+func (sh *sysvSum) BlockSize() int { return 1 }
+func (sh *sysvSum) Sum32() uint32  { return sysvFold(uint32(*sh)) }
+func (sh *sysvSum) Reset()         { *sh = sysvSum(0) }
+func (sh *sysvSum) Sum(b []byte) []byte {
+	v := sh.Sum32()
+	return append(b, byte(v>>8), byte(v))
+}
+
+func (sh *sysvSum) Write(b []byte) (int, error) {
+	s := uint32(*sh)
+	for _, c := range b {
+		s += uint32(c)
+	}
+	*sh = sysvSum(s)
+	return len(b), nil
+}
+
+// fold the 32-bit sum s to 16 bits
+func sysvFold(s uint32) uint32 {
+	r := (s & 0xffff) + (s >> 16)
+	return (r & 0xffff) + (r >> 16)
+}
+
+// CombineSysVSum returns the System V checksum of the concatenation of two blocks, given the checksum h1 of the first and h2 of the second.
+// It is exact as long as the sum of all the bytes fits in 32 bits, as it does for inputs of up to 16MB.
+func CombineSysVSum(h1, h2 uint32) uint32 {
+	return sysvFold(h1 + h2)
+}
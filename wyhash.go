@@ -0,0 +1,73 @@
+// This file is an implementation of wyhash (final version 4) by Wang Yi
+// The code is translated from the public domain source code at https://github.com/wangyi-fudan/wyhash
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// wyhash reads the input differently depending on its total length, so the
+// hash.Hash version buffers everything written until the sum is requested.
+
+package dgohash
+
+import (
+	"hash"
+	"math/bits"
+)
+
+// the default secret parameters
+var wyp = [4]uint64{0x2d358dccaa6c78a5, 0x8bb84b93962eacc9, 0x4b33a62ed433d4a3, 0x4d5a2da51de1aa47}
+
+// NewWyHash returns a new hash.Hash64 object computing wyhash with the given seed
+func NewWyHash(seed uint64) hash.Hash64 {
+	return &buffered64{f: func(data []byte) uint64 { return WyHash(data, seed) }, blockSize: 48}
+}
+
+// the _wyr3() function from the reference code
+func wyr3(p []byte, k int) uint64 {
+	return uint64(p[0])<<16 | uint64(p[k>>1])<<8 | uint64(p[k-1])
+}
+
+// WyHash returns the wyhash of data with the given seed
+func WyHash(data []byte, seed uint64) uint64 {
+
+	p := data
+	length := len(data)
+
+	seed ^= mul128fold64(seed^wyp[0], wyp[1])
+
+	var a, b uint64
+
+	if length <= 16 {
+		if length >= 4 {
+			a = uint64(readLE32(p))<<32 | uint64(readLE32(p[(length>>3)<<2:]))
+			b = uint64(readLE32(p[length-4:]))<<32 | uint64(readLE32(p[length-4-((length>>3)<<2):]))
+		} else if length > 0 {
+			a = wyr3(p, length)
+			b = 0
+		}
+	} else {
+		i := length
+		if i >= 48 {
+			see1, see2 := seed, seed
+			for ; i >= 48; i -= 48 {
+				seed = mul128fold64(readLE64(p)^wyp[1], readLE64(p[8:])^seed)
+				see1 = mul128fold64(readLE64(p[16:])^wyp[2], readLE64(p[24:])^see1)
+				see2 = mul128fold64(readLE64(p[32:])^wyp[3], readLE64(p[40:])^see2)
+				p = p[48:]
+			}
+			seed ^= see1 ^ see2
+		}
+		for ; i > 16; i -= 16 {
+			seed = mul128fold64(readLE64(p)^wyp[1], readLE64(p[8:])^seed)
+			p = p[16:]
+		}
+		// the last 16 bytes of the input, which may overlap bytes already mixed in
+		a = readLE64(data[length-16:])
+		b = readLE64(data[length-8:])
+	}
+
+	a ^= wyp[1]
+	b ^= seed
+	b, a = bits.Mul64(a, b)
+
+	return mul128fold64(a^wyp[0]^uint64(length), b^wyp[1])
+}
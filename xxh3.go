@@ -0,0 +1,698 @@
+// This file is an implementation of the XXH3 64-bit and 128-bit hash functions by Yann Collet
+// The code is translated from the BSD-licensed source code at https://github.com/Cyan4973/xxHash
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+// Only the portable scalar code path is implemented; the vectorised versions
+// in the reference code all compute the same values.
+
+package dgohash
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+// XXH3SecretSizeMin is the smallest custom secret accepted by the XXH3 functions
+const XXH3SecretSizeMin = 136
+
+const (
+	xxh3SecretDefaultSize = 192
+	xxh3MidsizeMax        = 240
+	xxh3StripeLen         = 64
+	xxh3SecretConsumeRate = 8
+	xxh3InternalBufSize   = 256
+
+	xxh3MidsizeStartOffset   = 3
+	xxh3MidsizeLastOffset    = 17
+	xxh3SecretLastAccStart   = 7
+	xxh3SecretMergeAccsStart = 11
+)
+
+const (
+	primeMX1 = uint64(0x165667919e3779f9)
+	primeMX2 = uint64(0x9fb21c651e98df25)
+)
+
+// pseudorandom secret taken directly from FARSH
+var xxh3kSecret = []byte{
+	0xb8, 0xfe, 0x6c, 0x39, 0x23, 0xa4, 0x4b, 0xbe, 0x7c, 0x01, 0x81, 0x2c, 0xf7, 0x21, 0xad, 0x1c,
+	0xde, 0xd4, 0x6d, 0xe9, 0x83, 0x90, 0x97, 0xdb, 0x72, 0x40, 0xa4, 0xa4, 0xb7, 0xb3, 0x67, 0x1f,
+	0xcb, 0x79, 0xe6, 0x4e, 0xcc, 0xc0, 0xe5, 0x78, 0x82, 0x5a, 0xd0, 0x7d, 0xcc, 0xff, 0x72, 0x21,
+	0xb8, 0x08, 0x46, 0x74, 0xf7, 0x43, 0x24, 0x8e, 0xe0, 0x35, 0x90, 0xe6, 0x81, 0x3a, 0x26, 0x4c,
+	0x3c, 0x28, 0x52, 0xbb, 0x91, 0xc3, 0x00, 0xcb, 0x88, 0xd0, 0x65, 0x8b, 0x1b, 0x53, 0x2e, 0xa3,
+	0x71, 0x64, 0x48, 0x97, 0xa2, 0x0d, 0xf9, 0x4e, 0x38, 0x19, 0xef, 0x46, 0xa9, 0xde, 0xac, 0xd8,
+	0xa8, 0xfa, 0x76, 0x3f, 0xe3, 0x9c, 0x34, 0x3f, 0xf9, 0xdc, 0xbb, 0xc7, 0xc7, 0x0b, 0x4f, 0x1d,
+	0x8a, 0x51, 0xe0, 0x4b, 0xcd, 0xb4, 0x59, 0x31, 0xc8, 0x9f, 0x7e, 0xc9, 0xd9, 0x78, 0x73, 0x64,
+	0xea, 0xc5, 0xac, 0x83, 0x34, 0xd3, 0xeb, 0xc3, 0xc5, 0x81, 0xa0, 0xff, 0xfa, 0x13, 0x63, 0xeb,
+	0x17, 0x0d, 0xdd, 0x51, 0xb7, 0xf0, 0xda, 0x49, 0xd3, 0x16, 0x55, 0x26, 0x29, 0xd4, 0x68, 0x9e,
+	0x2b, 0x16, 0xbe, 0x58, 0x7d, 0x47, 0xa1, 0xfc, 0x8f, 0xf8, 0xb8, 0xd1, 0x7a, 0xd0, 0x31, 0xce,
+	0x45, 0xcb, 0x3a, 0x8f, 0x95, 0x16, 0x04, 0x28, 0xaf, 0xd7, 0xfb, 0xca, 0xbb, 0x4b, 0x40, 0x7e,
+}
+
+func readLE32(p []byte) uint32 { return binary.LittleEndian.Uint32(p) }
+func readLE64(p []byte) uint64 { return binary.LittleEndian.Uint64(p) }
+
+// the low and high halves of the 128-bit product of a and b, xor'd together
+func mul128fold64(a, b uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return hi ^ lo
+}
+
+func xxh3Avalanche(h64 uint64) uint64 {
+	h64 ^= h64 >> 37
+	h64 *= primeMX1
+	h64 ^= h64 >> 32
+	return h64
+}
+
+func xxh3rrmxmx(h64 uint64, length uint64) uint64 {
+	h64 ^= rotl64(h64, 49) ^ rotl64(h64, 24)
+	h64 *= primeMX2
+	h64 ^= (h64 >> 35) + length
+	h64 *= primeMX2
+	h64 ^= h64 >> 28
+	return h64
+}
+
+func xxh3mix16B(input, secret []byte, seed uint64) uint64 {
+	inputLo := readLE64(input)
+	inputHi := readLE64(input[8:])
+	return mul128fold64(
+		inputLo^(readLE64(secret)+seed),
+		inputHi^(readLE64(secret[8:])-seed),
+	)
+}
+
+func xxh3len0to16_64(input, secret []byte, seed uint64) uint64 {
+
+	length := len(input)
+
+	switch {
+	case length > 8:
+		bitflip1 := (readLE64(secret[24:]) ^ readLE64(secret[32:])) + seed
+		bitflip2 := (readLE64(secret[40:]) ^ readLE64(secret[48:])) - seed
+		inputLo := readLE64(input) ^ bitflip1
+		inputHi := readLE64(input[length-8:]) ^ bitflip2
+		acc := uint64(length) + bits.ReverseBytes64(inputLo) + inputHi + mul128fold64(inputLo, inputHi)
+		return xxh3Avalanche(acc)
+
+	case length >= 4:
+		seed ^= uint64(bits.ReverseBytes32(uint32(seed))) << 32
+		input1 := readLE32(input)
+		input2 := readLE32(input[length-4:])
+		bitflip := (readLE64(secret[8:]) ^ readLE64(secret[16:])) - seed
+		input64 := uint64(input2) + uint64(input1)<<32
+		return xxh3rrmxmx(input64^bitflip, uint64(length))
+
+	case length > 0:
+		c1 := input[0]
+		c2 := input[length>>1]
+		c3 := input[length-1]
+		combined := uint32(c1)<<16 | uint32(c2)<<24 | uint32(c3) | uint32(length)<<8
+		bitflip := uint64(readLE32(secret)^readLE32(secret[4:])) + seed
+		return xxh64Avalanche(uint64(combined) ^ bitflip)
+	}
+
+	return xxh64Avalanche(seed ^ (readLE64(secret[56:]) ^ readLE64(secret[64:])))
+}
+
+func xxh3len17to128_64(input, secret []byte, seed uint64) uint64 {
+
+	length := len(input)
+
+	acc := uint64(length) * prime64_1
+
+	if length > 32 {
+		if length > 64 {
+			if length > 96 {
+				acc += xxh3mix16B(input[48:], secret[96:], seed)
+				acc += xxh3mix16B(input[length-64:], secret[112:], seed)
+			}
+			acc += xxh3mix16B(input[32:], secret[64:], seed)
+			acc += xxh3mix16B(input[length-48:], secret[80:], seed)
+		}
+		acc += xxh3mix16B(input[16:], secret[32:], seed)
+		acc += xxh3mix16B(input[length-32:], secret[48:], seed)
+	}
+	acc += xxh3mix16B(input[0:], secret[0:], seed)
+	acc += xxh3mix16B(input[length-16:], secret[16:], seed)
+
+	return xxh3Avalanche(acc)
+}
+
+func xxh3len129to240_64(input, secret []byte, seed uint64) uint64 {
+
+	length := len(input)
+
+	acc := uint64(length) * prime64_1
+
+	nbRounds := length / 16
+
+	for i := 0; i < 8; i++ {
+		acc += xxh3mix16B(input[16*i:], secret[16*i:], seed)
+	}
+
+	// last bytes
+	accEnd := xxh3mix16B(input[length-16:], secret[XXH3SecretSizeMin-xxh3MidsizeLastOffset:], seed)
+
+	acc = xxh3Avalanche(acc)
+
+	for i := 8; i < nbRounds; i++ {
+		accEnd += xxh3mix16B(input[16*i:], secret[16*(i-8)+xxh3MidsizeStartOffset:], seed)
+	}
+
+	return xxh3Avalanche(acc + accEnd)
+}
+
+func xxh3initAcc(acc *[8]uint64) {
+	*acc = [8]uint64{
+		uint64(prime32_3), prime64_1, prime64_2, prime64_3,
+		prime64_4, uint64(prime32_2), prime64_5, uint64(prime32_1),
+	}
+}
+
+func xxh3accumulate512(acc *[8]uint64, input, secret []byte) {
+	for i := 0; i < 8; i++ {
+		dataVal := readLE64(input[8*i:])
+		dataKey := dataVal ^ readLE64(secret[8*i:])
+		acc[i^1] += dataVal // swap adjacent lanes
+		acc[i] += uint64(uint32(dataKey)) * (dataKey >> 32)
+	}
+}
+
+func xxh3accumulate(acc *[8]uint64, input, secret []byte, nbStripes int) {
+	for n := 0; n < nbStripes; n++ {
+		xxh3accumulate512(acc, input[n*xxh3StripeLen:], secret[n*xxh3SecretConsumeRate:])
+	}
+}
+
+func xxh3scrambleAcc(acc *[8]uint64, secret []byte) {
+	for i := range acc {
+		a := acc[i]
+		a ^= a >> 47
+		a ^= readLE64(secret[8*i:])
+		a *= uint64(prime32_1)
+		acc[i] = a
+	}
+}
+
+func xxh3hashLongLoop(acc *[8]uint64, input, secret []byte) {
+
+	length := len(input)
+
+	nbStripesPerBlock := (len(secret) - xxh3StripeLen) / xxh3SecretConsumeRate
+	blockLen := xxh3StripeLen * nbStripesPerBlock
+	nbBlocks := (length - 1) / blockLen
+
+	for n := 0; n < nbBlocks; n++ {
+		xxh3accumulate(acc, input[n*blockLen:], secret, nbStripesPerBlock)
+		xxh3scrambleAcc(acc, secret[len(secret)-xxh3StripeLen:])
+	}
+
+	// last partial block
+	nbStripes := ((length - 1) - (blockLen * nbBlocks)) / xxh3StripeLen
+	xxh3accumulate(acc, input[nbBlocks*blockLen:], secret, nbStripes)
+
+	// last stripe
+	xxh3accumulate512(acc, input[length-xxh3StripeLen:], secret[len(secret)-xxh3StripeLen-xxh3SecretLastAccStart:])
+}
+
+func xxh3mergeAccs(acc *[8]uint64, secret []byte, start uint64) uint64 {
+	result := start
+	for i := 0; i < 4; i++ {
+		result += mul128fold64(acc[2*i]^readLE64(secret[16*i:]), acc[2*i+1]^readLE64(secret[16*i+8:]))
+	}
+	return xxh3Avalanche(result)
+}
+
+func xxh3hashLong64(input, secret []byte) uint64 {
+	var acc [8]uint64
+	xxh3initAcc(&acc)
+	xxh3hashLongLoop(&acc, input, secret)
+	return xxh3mergeAccs(&acc, secret[xxh3SecretMergeAccsStart:], uint64(len(input))*prime64_1)
+}
+
+// xxh3initCustomSecret derives a secret from the default one and seed
+func xxh3initCustomSecret(seed uint64) []byte {
+	secret := make([]byte, xxh3SecretDefaultSize)
+	for i := 0; i < xxh3SecretDefaultSize; i += 16 {
+		binary.LittleEndian.PutUint64(secret[i:], readLE64(xxh3kSecret[i:])+seed)
+		binary.LittleEndian.PutUint64(secret[i+8:], readLE64(xxh3kSecret[i+8:])-seed)
+	}
+	return secret
+}
+
+// xxh3short64 hashes inputs of at most xxh3MidsizeMax bytes
+func xxh3short64(input, secret []byte, seed uint64) uint64 {
+	switch length := len(input); {
+	case length <= 16:
+		return xxh3len0to16_64(input, secret, seed)
+	case length <= 128:
+		return xxh3len17to128_64(input, secret, seed)
+	}
+	return xxh3len129to240_64(input, secret, seed)
+}
+
+func checkXXH3Secret(secret []byte) {
+	if len(secret) < XXH3SecretSizeMin {
+		panic("dgohash: XXH3 secret too short")
+	}
+}
+
+// XXH3_64 returns the XXH3 64-bit hash of data
+func XXH3_64(data []byte) uint64 {
+	if len(data) <= xxh3MidsizeMax {
+		return xxh3short64(data, xxh3kSecret, 0)
+	}
+	return xxh3hashLong64(data, xxh3kSecret)
+}
+
+// XXH3_64Seed returns the XXH3 64-bit hash of data with the given seed
+func XXH3_64Seed(data []byte, seed uint64) uint64 {
+	if len(data) <= xxh3MidsizeMax {
+		return xxh3short64(data, xxh3kSecret, seed)
+	}
+	if seed == 0 {
+		return xxh3hashLong64(data, xxh3kSecret)
+	}
+	return xxh3hashLong64(data, xxh3initCustomSecret(seed))
+}
+
+// XXH3_64Secret returns the XXH3 64-bit hash of data using a custom secret.
+// It panics if the secret is shorter than XXH3SecretSizeMin bytes.
+func XXH3_64Secret(data []byte, secret []byte) uint64 {
+	checkXXH3Secret(secret)
+	if len(data) <= xxh3MidsizeMax {
+		return xxh3short64(data, secret, 0)
+	}
+	return xxh3hashLong64(data, secret)
+}
+
+func xxh3len0to16_128(input, secret []byte, seed uint64) (uint64, uint64) {
+
+	length := len(input)
+
+	switch {
+	case length > 8:
+		bitflipl := (readLE64(secret[32:]) ^ readLE64(secret[40:])) - seed
+		bitfliph := (readLE64(secret[48:]) ^ readLE64(secret[56:])) + seed
+		inputLo := readLE64(input)
+		inputHi := readLE64(input[length-8:])
+
+		mHi, mLo := bits.Mul64(inputLo^inputHi^bitflipl, prime64_1)
+
+		mLo += uint64(length-1) << 54
+		inputHi ^= bitfliph
+
+		mHi += inputHi + uint64(uint32(inputHi))*uint64(prime32_2-1)
+
+		mLo ^= bits.ReverseBytes64(mHi)
+
+		hHi, hLo := bits.Mul64(mLo, prime64_2)
+		hHi += mHi * prime64_2
+
+		return xxh3Avalanche(hHi), xxh3Avalanche(hLo)
+
+	case length >= 4:
+		seed ^= uint64(bits.ReverseBytes32(uint32(seed))) << 32
+		inputLo := readLE32(input)
+		inputHi := readLE32(input[length-4:])
+		input64 := uint64(inputLo) + uint64(inputHi)<<32
+		bitflip := (readLE64(secret[16:]) ^ readLE64(secret[24:])) + seed
+		keyed := input64 ^ bitflip
+
+		mHi, mLo := bits.Mul64(keyed, prime64_1+uint64(length)<<2)
+
+		mHi += mLo << 1
+		mLo ^= mHi >> 3
+
+		mLo ^= mLo >> 35
+		mLo *= primeMX2
+		mLo ^= mLo >> 28
+
+		return xxh3Avalanche(mHi), mLo
+
+	case length > 0:
+		c1 := input[0]
+		c2 := input[length>>1]
+		c3 := input[length-1]
+		combinedl := uint32(c1)<<16 | uint32(c2)<<24 | uint32(c3) | uint32(length)<<8
+		combinedh := rotl32(bits.ReverseBytes32(combinedl), 13)
+		bitflipl := uint64(readLE32(secret)^readLE32(secret[4:])) + seed
+		bitfliph := uint64(readLE32(secret[8:])^readLE32(secret[12:])) - seed
+		return xxh64Avalanche(uint64(combinedh) ^ bitfliph), xxh64Avalanche(uint64(combinedl) ^ bitflipl)
+	}
+
+	bitflipl := readLE64(secret[64:]) ^ readLE64(secret[72:])
+	bitfliph := readLE64(secret[80:]) ^ readLE64(secret[88:])
+	return xxh64Avalanche(seed ^ bitfliph), xxh64Avalanche(seed ^ bitflipl)
+}
+
+func xxh128mix32B(accLo, accHi uint64, input1, input2, secret []byte, seed uint64) (uint64, uint64) {
+	accLo += xxh3mix16B(input1, secret[0:], seed)
+	accLo ^= readLE64(input2) + readLE64(input2[8:])
+	accHi += xxh3mix16B(input2, secret[16:], seed)
+	accHi ^= readLE64(input1) + readLE64(input1[8:])
+	return accLo, accHi
+}
+
+// the common ending of the 17to128 and 129to240 128-bit hashes
+func xxh128finalMix(accLo, accHi uint64, length int, seed uint64) (uint64, uint64) {
+	lo := accLo + accHi
+	hi := accLo*prime64_1 + accHi*prime64_4 + (uint64(length)-seed)*prime64_2
+	return 0 - xxh3Avalanche(hi), xxh3Avalanche(lo)
+}
+
+func xxh3len17to128_128(input, secret []byte, seed uint64) (uint64, uint64) {
+
+	length := len(input)
+
+	accLo := uint64(length) * prime64_1
+	accHi := uint64(0)
+
+	if length > 32 {
+		if length > 64 {
+			if length > 96 {
+				accLo, accHi = xxh128mix32B(accLo, accHi, input[48:], input[length-64:], secret[96:], seed)
+			}
+			accLo, accHi = xxh128mix32B(accLo, accHi, input[32:], input[length-48:], secret[64:], seed)
+		}
+		accLo, accHi = xxh128mix32B(accLo, accHi, input[16:], input[length-32:], secret[32:], seed)
+	}
+	accLo, accHi = xxh128mix32B(accLo, accHi, input, input[length-16:], secret, seed)
+
+	return xxh128finalMix(accLo, accHi, length, seed)
+}
+
+func xxh3len129to240_128(input, secret []byte, seed uint64) (uint64, uint64) {
+
+	length := len(input)
+
+	accLo := uint64(length) * prime64_1
+	accHi := uint64(0)
+
+	for i := 32; i < 160; i += 32 {
+		accLo, accHi = xxh128mix32B(accLo, accHi, input[i-32:], input[i-16:], secret[i-32:], seed)
+	}
+
+	accLo = xxh3Avalanche(accLo)
+	accHi = xxh3Avalanche(accHi)
+
+	for i := 160; i <= length; i += 32 {
+		accLo, accHi = xxh128mix32B(accLo, accHi, input[i-32:], input[i-16:], secret[xxh3MidsizeStartOffset+i-160:], seed)
+	}
+
+	// last bytes
+	accLo, accHi = xxh128mix32B(accLo, accHi, input[length-16:], input[length-32:],
+		secret[XXH3SecretSizeMin-xxh3MidsizeLastOffset-16:], 0-seed)
+
+	return xxh128finalMix(accLo, accHi, length, seed)
+}
+
+func xxh3hashLong128(input, secret []byte) (uint64, uint64) {
+	var acc [8]uint64
+	xxh3initAcc(&acc)
+	xxh3hashLongLoop(&acc, input, secret)
+	return xxh3mergeAccs128(&acc, secret, uint64(len(input)))
+}
+
+func xxh3mergeAccs128(acc *[8]uint64, secret []byte, length uint64) (uint64, uint64) {
+	lo := xxh3mergeAccs(acc, secret[xxh3SecretMergeAccsStart:], length*prime64_1)
+	hi := xxh3mergeAccs(acc, secret[len(secret)-len(acc)*8-xxh3SecretMergeAccsStart:], ^(length * prime64_2))
+	return hi, lo
+}
+
+// xxh3short128 hashes inputs of at most xxh3MidsizeMax bytes
+func xxh3short128(input, secret []byte, seed uint64) (uint64, uint64) {
+	switch length := len(input); {
+	case length <= 16:
+		return xxh3len0to16_128(input, secret, seed)
+	case length <= 128:
+		return xxh3len17to128_128(input, secret, seed)
+	}
+	return xxh3len129to240_128(input, secret, seed)
+}
+
+// XXH3_128 returns the high and low 64 bits of the XXH3 128-bit hash of data
+func XXH3_128(data []byte) (uint64, uint64) {
+	if len(data) <= xxh3MidsizeMax {
+		return xxh3short128(data, xxh3kSecret, 0)
+	}
+	return xxh3hashLong128(data, xxh3kSecret)
+}
+
+// XXH3_128Seed returns the high and low 64 bits of the XXH3 128-bit hash of data with the given seed
+func XXH3_128Seed(data []byte, seed uint64) (uint64, uint64) {
+	if len(data) <= xxh3MidsizeMax {
+		return xxh3short128(data, xxh3kSecret, seed)
+	}
+	if seed == 0 {
+		return xxh3hashLong128(data, xxh3kSecret)
+	}
+	return xxh3hashLong128(data, xxh3initCustomSecret(seed))
+}
+
+// XXH3_128Secret returns the high and low 64 bits of the XXH3 128-bit hash of data using a custom secret.
+// It panics if the secret is shorter than XXH3SecretSizeMin bytes.
+func XXH3_128Secret(data []byte, secret []byte) (uint64, uint64) {
+	checkXXH3Secret(secret)
+	if len(data) <= xxh3MidsizeMax {
+		return xxh3short128(data, secret, 0)
+	}
+	return xxh3hashLong128(data, secret)
+}
+
+// xxh3state is the streaming state shared by the 64- and 128-bit hashes
+type xxh3state struct {
+	seed           uint64
+	secret         []byte // used for inputs longer than xxh3MidsizeMax
+	acc            [8]uint64
+	nbStripesSoFar int
+	totalLen       uint64
+	buf            [xxh3InternalBufSize]byte // as-yet-unprocessed bytes
+	rem            int                       // how many bytes in buf[] are valid
+}
+
+func (x *xxh3state) init(seed uint64, secret []byte) {
+	x.seed = seed
+	switch {
+	case secret != nil:
+		x.secret = secret
+	case seed != 0:
+		x.secret = xxh3initCustomSecret(seed)
+	default:
+		x.secret = xxh3kSecret
+	}
+	x.Reset()
+}
+
+func (x *xxh3state) BlockSize() int { return xxh3StripeLen }
+func (x *xxh3state) Reset() {
+	xxh3initAcc(&x.acc)
+	x.nbStripesSoFar = 0
+	x.totalLen = 0
+	x.rem = 0
+}
+
+// shortSecret is the secret used for inputs of at most xxh3MidsizeMax bytes.
+// The seeded variants use the seed directly rather than the derived secret.
+func (x *xxh3state) shortSecret() []byte {
+	if x.seed != 0 {
+		return xxh3kSecret
+	}
+	return x.secret
+}
+
+func xxh3consumeStripes(acc *[8]uint64, nbStripesSoFar *int, input []byte, nbStripes int, secret []byte) {
+
+	nbStripesPerBlock := (len(secret) - xxh3StripeLen) / xxh3SecretConsumeRate
+	secretLimit := len(secret) - xxh3StripeLen
+
+	initialSecret := secret[*nbStripesSoFar*xxh3SecretConsumeRate:]
+
+	// process full blocks
+	if nbStripes >= nbStripesPerBlock-*nbStripesSoFar {
+		nbStripesThisIter := nbStripesPerBlock - *nbStripesSoFar
+		for {
+			xxh3accumulate(acc, input, initialSecret, nbStripesThisIter)
+			xxh3scrambleAcc(acc, secret[secretLimit:])
+			input = input[nbStripesThisIter*xxh3StripeLen:]
+			nbStripes -= nbStripesThisIter
+			nbStripesThisIter = nbStripesPerBlock
+			initialSecret = secret
+			if nbStripes < nbStripesPerBlock {
+				break
+			}
+		}
+		*nbStripesSoFar = 0
+	}
+
+	// process a partial block
+	if nbStripes > 0 {
+		xxh3accumulate(acc, input, initialSecret, nbStripes)
+		*nbStripesSoFar += nbStripes
+	}
+}
+
+func (x *xxh3state) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+
+	x.totalLen += uint64(datalen)
+
+	// Short inputs are hashed from the buffer at the end, and we always
+	// hold back the last stripe since it's processed differently
+	if datalen <= len(x.buf)-x.rem {
+		copy(x.buf[x.rem:], data)
+		x.rem += datalen
+		return datalen, nil
+	}
+
+	// complete and consume the buffer
+	if x.rem != 0 {
+		n := copy(x.buf[x.rem:], data)
+		data = data[n:]
+		xxh3consumeStripes(&x.acc, &x.nbStripesSoFar, x.buf[:], len(x.buf)/xxh3StripeLen, x.secret)
+		x.rem = 0
+	}
+
+	// consume the input directly, keeping a copy of the last stripe in
+	// case the final partial stripe needs it
+	if len(data) > len(x.buf) {
+		nbStripes := (len(data) - 1) / xxh3StripeLen
+		xxh3consumeStripes(&x.acc, &x.nbStripesSoFar, data, nbStripes, x.secret)
+		n := nbStripes * xxh3StripeLen
+		copy(x.buf[len(x.buf)-xxh3StripeLen:], data[n-xxh3StripeLen:n])
+		data = data[n:]
+	}
+
+	// copy the tail for later
+	x.rem = copy(x.buf[:], data)
+
+	return datalen, nil
+}
+
+// digestLong returns the accumulators after processing the buffered tail
+func (x *xxh3state) digestLong() [8]uint64 {
+
+	// copy so as not to change the internal state
+	acc := x.acc
+
+	var lastStripe []byte
+
+	if x.rem >= xxh3StripeLen {
+		nbStripes := (x.rem - 1) / xxh3StripeLen
+		nbStripesSoFar := x.nbStripesSoFar
+		xxh3consumeStripes(&acc, &nbStripesSoFar, x.buf[:], nbStripes, x.secret)
+		lastStripe = x.buf[x.rem-xxh3StripeLen : x.rem]
+	} else {
+		// the last stripe is made up of the end of the previous stripe and what we have buffered
+		var t [xxh3StripeLen]byte
+		catchup := xxh3StripeLen - x.rem
+		copy(t[:], x.buf[len(x.buf)-catchup:])
+		copy(t[catchup:], x.buf[:x.rem])
+		lastStripe = t[:]
+	}
+
+	xxh3accumulate512(&acc, lastStripe, x.secret[len(x.secret)-xxh3StripeLen-xxh3SecretLastAccStart:])
+
+	return acc
+}
+
+type xxh3_64 struct {
+	xxh3state
+}
+
+// NewXXH3_64 returns a new hash.Hash64 object computing the XXH3 64-bit hash
+func NewXXH3_64() hash.Hash64 {
+	x := new(xxh3_64)
+	x.init(0, nil)
+	return x
+}
+
+// NewXXH3_64Seed returns a new hash.Hash64 object computing the XXH3 64-bit hash with the given seed
+func NewXXH3_64Seed(seed uint64) hash.Hash64 {
+	x := new(xxh3_64)
+	x.init(seed, nil)
+	return x
+}
+
+// NewXXH3_64Secret returns a new hash.Hash64 object computing the XXH3 64-bit hash using a custom secret.
+// The secret is not copied.  It panics if the secret is shorter than XXH3SecretSizeMin bytes.
+func NewXXH3_64Secret(secret []byte) hash.Hash64 {
+	checkXXH3Secret(secret)
+	x := new(xxh3_64)
+	x.init(0, secret)
+	return x
+}
+
+func (x *xxh3_64) Size() int { return 8 }
+
+func (x *xxh3_64) Sum(b []byte) []byte {
+	h := x.Sum64()
+	return append(b, byte(h>>56), byte(h>>48), byte(h>>40), byte(h>>32), byte(h>>24), byte(h>>16), byte(h>>8), byte(h))
+}
+
+// xxh3 64-bit finalize step
+func (x *xxh3_64) Sum64() uint64 {
+
+	if x.totalLen > xxh3MidsizeMax {
+		acc := x.digestLong()
+		return xxh3mergeAccs(&acc, x.secret[xxh3SecretMergeAccsStart:], x.totalLen*prime64_1)
+	}
+
+	return xxh3short64(x.buf[:x.rem], x.shortSecret(), x.seed)
+}
+
+type xxh3_128 struct {
+	xxh3state
+}
+
+// NewXXH3_128 returns a new Hash128 object computing the XXH3 128-bit hash.
+// Sum128 returns the high and low 64 bits of the hash, in that order.
+func NewXXH3_128() Hash128 {
+	x := new(xxh3_128)
+	x.init(0, nil)
+	return x
+}
+
+// NewXXH3_128Seed returns a new Hash128 object computing the XXH3 128-bit hash with the given seed
+func NewXXH3_128Seed(seed uint64) Hash128 {
+	x := new(xxh3_128)
+	x.init(seed, nil)
+	return x
+}
+
+// NewXXH3_128Secret returns a new Hash128 object computing the XXH3 128-bit hash using a custom secret.
+// The secret is not copied.  It panics if the secret is shorter than XXH3SecretSizeMin bytes.
+func NewXXH3_128Secret(secret []byte) Hash128 {
+	checkXXH3Secret(secret)
+	x := new(xxh3_128)
+	x.init(0, secret)
+	return x
+}
+
+func (x *xxh3_128) Size() int { return 16 }
+
+func (x *xxh3_128) Sum(b []byte) []byte {
+	h1, h2 := x.Sum128()
+	return appendSum128(b, h1, h2)
+}
+
+func (x *xxh3_128) Sum64() uint64 {
+	h1, _ := x.Sum128()
+	return h1
+}
+
+// xxh3 128-bit finalize step
+func (x *xxh3_128) Sum128() (uint64, uint64) {
+
+	if x.totalLen > xxh3MidsizeMax {
+		acc := x.digestLong()
+		return xxh3mergeAccs128(&acc, x.secret, x.totalLen)
+	}
+
+	return xxh3short128(x.buf[:x.rem], x.shortSecret(), x.seed)
+}
@@ -0,0 +1,296 @@
+// This file is an implementation of the xxHash32 and xxHash64 hash functions by Yann Collet
+// The code is translated from the BSD-licensed source code at https://github.com/Cyan4973/xxHash
+// This implementation Copyright (c) 2011 Damian Gryski <damian@gryski.com>
+// Licensed under the GPLv3, or at your option any later version
+
+package dgohash
+
+import (
+	"encoding/binary"
+	"hash"
+)
+
+const (
+	prime32_1 = uint32(0x9e3779b1)
+	prime32_2 = uint32(0x85ebca77)
+	prime32_3 = uint32(0xc2b2ae3d)
+	prime32_4 = uint32(0x27d4eb2f)
+	prime32_5 = uint32(0x165667b1)
+)
+
+const (
+	prime64_1 = uint64(0x9e3779b185ebca87)
+	prime64_2 = uint64(0xc2b2ae3d27d4eb4f)
+	prime64_3 = uint64(0x165667b19e3779f9)
+	prime64_4 = uint64(0x85ebca77c2b2ae63)
+	prime64_5 = uint64(0x27d4eb2f165667c5)
+)
+
+type xxh32 struct {
+	seed           uint32
+	v1, v2, v3, v4 uint32   // our hash state
+	length         uint64   // current bytes written so far (needed for finalize)
+	t              [16]byte // as-yet-unprocessed bytes
+	rem            int      // how many bytes in t[] are valid
+}
+
+// NewXXH32 returns a new hash.Hash32 object computing xxHash32 with the given seed
+func NewXXH32(seed uint32) hash.Hash32 {
+	x := new(xxh32)
+	x.seed = seed
+	x.Reset()
+	return x
+}
+
+func (x *xxh32) Size() int      { return 4 }
+func (x *xxh32) BlockSize() int { return 16 }
+func (x *xxh32) Reset() {
+	x.v1 = x.seed + prime32_1 + prime32_2
+	x.v2 = x.seed + prime32_2
+	x.v3 = x.seed
+	x.v4 = x.seed - prime32_1
+	x.length = 0
+	x.rem = 0
+}
+
+func xxh32round(acc, input uint32) uint32 {
+	acc += input * prime32_2
+	acc = rotl32(acc, 13)
+	acc *= prime32_1
+	return acc
+}
+
+// computes new hash state merged with the 16-byte stripe in p
+func (x *xxh32) update(p []byte) {
+	x.v1 = xxh32round(x.v1, binary.LittleEndian.Uint32(p[0:]))
+	x.v2 = xxh32round(x.v2, binary.LittleEndian.Uint32(p[4:]))
+	x.v3 = xxh32round(x.v3, binary.LittleEndian.Uint32(p[8:]))
+	x.v4 = xxh32round(x.v4, binary.LittleEndian.Uint32(p[12:]))
+}
+
+func (x *xxh32) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+
+	x.length += uint64(datalen)
+
+	// Keep track of the bytes that don't yet make up a full stripe, and
+	// process them on the next round if we can scrounge together enough.
+	// If they're not merged here, they're pulled in during the finalize step
+	if x.rem != 0 {
+
+		n := copy(x.t[x.rem:], data)
+		x.rem += n
+
+		if x.rem < 16 {
+			return datalen, nil
+		}
+
+		x.update(x.t[:])
+
+		// nothing is left in the tail
+		x.rem = 0
+		data = data[n:]
+	}
+
+	length := len(data)
+
+	// figure out the length of the tail, and round down b
+	rem := length & 15
+	b := length - rem
+
+	for i := 0; i < b; i += 16 {
+		x.update(data[i:])
+	}
+
+	// copy the tail for later
+	copy(x.t[:rem], data[b:])
+
+	x.rem = rem
+
+	return datalen, nil
+}
+
+func (x *xxh32) Sum(b []byte) []byte {
+	h := x.Sum32()
+	return append(b, byte(h>>24), byte(h>>16), byte(h>>8), byte(h))
+}
+
+// xxh32 finalize step
+func (x *xxh32) Sum32() uint32 {
+
+	var h32 uint32
+
+	if x.length >= 16 {
+		h32 = rotl32(x.v1, 1) + rotl32(x.v2, 7) + rotl32(x.v3, 12) + rotl32(x.v4, 18)
+	} else {
+		h32 = x.seed + prime32_5
+	}
+
+	h32 += uint32(x.length)
+
+	p := x.t[:x.rem]
+
+	for len(p) >= 4 {
+		h32 += binary.LittleEndian.Uint32(p) * prime32_3
+		h32 = rotl32(h32, 17) * prime32_4
+		p = p[4:]
+	}
+
+	for _, c := range p {
+		h32 += uint32(c) * prime32_5
+		h32 = rotl32(h32, 11) * prime32_1
+	}
+
+	h32 ^= h32 >> 15
+	h32 *= prime32_2
+	h32 ^= h32 >> 13
+	h32 *= prime32_3
+	h32 ^= h32 >> 16
+
+	return h32
+}
+
+type xxh64 struct {
+	seed           uint64
+	v1, v2, v3, v4 uint64   // our hash state
+	length         uint64   // current bytes written so far (needed for finalize)
+	t              [32]byte // as-yet-unprocessed bytes
+	rem            int      // how many bytes in t[] are valid
+}
+
+// NewXXH64 returns a new hash.Hash64 object computing xxHash64 with the given seed
+func NewXXH64(seed uint64) hash.Hash64 {
+	x := new(xxh64)
+	x.seed = seed
+	x.Reset()
+	return x
+}
+
+func (x *xxh64) Size() int      { return 8 }
+func (x *xxh64) BlockSize() int { return 32 }
+func (x *xxh64) Reset() {
+	x.v1 = x.seed + prime64_1 + prime64_2
+	x.v2 = x.seed + prime64_2
+	x.v3 = x.seed
+	x.v4 = x.seed - prime64_1
+	x.length = 0
+	x.rem = 0
+}
+
+func xxh64round(acc, input uint64) uint64 {
+	acc += input * prime64_2
+	acc = rotl64(acc, 31)
+	acc *= prime64_1
+	return acc
+}
+
+func xxh64mergeRound(acc, val uint64) uint64 {
+	val = xxh64round(0, val)
+	acc ^= val
+	acc = acc*prime64_1 + prime64_4
+	return acc
+}
+
+// computes new hash state merged with the 32-byte stripe in p
+func (x *xxh64) update(p []byte) {
+	x.v1 = xxh64round(x.v1, binary.LittleEndian.Uint64(p[0:]))
+	x.v2 = xxh64round(x.v2, binary.LittleEndian.Uint64(p[8:]))
+	x.v3 = xxh64round(x.v3, binary.LittleEndian.Uint64(p[16:]))
+	x.v4 = xxh64round(x.v4, binary.LittleEndian.Uint64(p[24:]))
+}
+
+// virtually identical to xxh32:Write()
+func (x *xxh64) Write(data []byte) (int, error) {
+
+	datalen := len(data)
+
+	x.length += uint64(datalen)
+
+	if x.rem != 0 {
+
+		n := copy(x.t[x.rem:], data)
+		x.rem += n
+
+		if x.rem < 32 {
+			return datalen, nil
+		}
+
+		x.update(x.t[:])
+
+		// nothing is left in the tail
+		x.rem = 0
+		data = data[n:]
+	}
+
+	length := len(data)
+
+	// figure out the length of the tail, and round down b
+	rem := length & 31
+	b := length - rem
+
+	for i := 0; i < b; i += 32 {
+		x.update(data[i:])
+	}
+
+	// copy the tail for later
+	copy(x.t[:rem], data[b:])
+
+	x.rem = rem
+
+	return datalen, nil
+}
+
+func (x *xxh64) Sum(b []byte) []byte {
+	h := x.Sum64()
+	return append(b, byte(h>>56), byte(h>>48), byte(h>>40), byte(h>>32), byte(h>>24), byte(h>>16), byte(h>>8), byte(h))
+}
+
+// xxh64 finalize step
+func (x *xxh64) Sum64() uint64 {
+
+	var h64 uint64
+
+	if x.length >= 32 {
+		h64 = rotl64(x.v1, 1) + rotl64(x.v2, 7) + rotl64(x.v3, 12) + rotl64(x.v4, 18)
+		h64 = xxh64mergeRound(h64, x.v1)
+		h64 = xxh64mergeRound(h64, x.v2)
+		h64 = xxh64mergeRound(h64, x.v3)
+		h64 = xxh64mergeRound(h64, x.v4)
+	} else {
+		h64 = x.seed + prime64_5
+	}
+
+	h64 += x.length
+
+	p := x.t[:x.rem]
+
+	for len(p) >= 8 {
+		k1 := xxh64round(0, binary.LittleEndian.Uint64(p))
+		h64 ^= k1
+		h64 = rotl64(h64, 27)*prime64_1 + prime64_4
+		p = p[8:]
+	}
+
+	if len(p) >= 4 {
+		h64 ^= uint64(binary.LittleEndian.Uint32(p)) * prime64_1
+		h64 = rotl64(h64, 23)*prime64_2 + prime64_3
+		p = p[4:]
+	}
+
+	for _, c := range p {
+		h64 ^= uint64(c) * prime64_5
+		h64 = rotl64(h64, 11) * prime64_1
+	}
+
+	return xxh64Avalanche(h64)
+}
+
+func xxh64Avalanche(h64 uint64) uint64 {
+	h64 ^= h64 >> 33
+	h64 *= prime64_2
+	h64 ^= h64 >> 29
+	h64 *= prime64_3
+	h64 ^= h64 >> 32
+	return h64
+}